@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
+	gameId     = flag.String("game", "", "Past game to analyze, from the server")
+	dealFile   = flag.String("file", "", "PBN file with the deal to analyze, or a .history file kept by a server")
+	board      = flag.Int("board", 0, "Board number to analyze from the -file (default first)")
+	samples    = flag.Int("samples", player.DefaultAnalysisConfig.Samples, "Deals sampled to evaluate each decision, when analyzing a -file")
+	jsonOutput = flag.Bool("json", false, "Print the analysis as JSON")
+	serverType = "lan"
+)
+
+func init() {
+	client.AddServerFlag(&serverType, "server")
+}
+
+func main() {
+	flag.Parse()
+	a, err := analyze()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *jsonOutput {
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(a.ToProto())
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+	fmt.Print(a)
+}
+
+// Asks the server to analyze the -game, or else analyzes the deal in the -file.
+func analyze() (client.GameAnalysis, error) {
+	if *gameId != "" {
+		stype, err := client.ServerTypeFromFlag(serverType)
+		if err != nil {
+			return client.GameAnalysis{}, err
+		}
+		conn, err := client.Connect(stype, *verbose)
+		if err != nil {
+			return client.GameAnalysis{}, fmt.Errorf("couldn't connect to server: %w", err)
+		}
+		defer conn.Close()
+		return conn.AnalyzeGame(context.Background(), *gameId)
+	}
+	config := player.DefaultAnalysisConfig
+	config.Samples = *samples
+	if strings.HasSuffix(*dealFile, ".history") {
+		h, err := client.ReadHistoryFile(*dealFile)
+		if err != nil {
+			return client.GameAnalysis{}, err
+		}
+		return player.AnalyzeHistory(context.Background(), h, config)
+	}
+	d, id, err := readDeal()
+	if err != nil {
+		return client.GameAnalysis{}, err
+	}
+	a, err := player.AnalyzeGame(d, config)
+	if err != nil {
+		return client.GameAnalysis{}, err
+	}
+	a.Id = id
+	return a, nil
+}
+
+// Reads the deal to analyze from the -file, returning it with a name for it.
+func readDeal() (cards.DealRecord, string, error) {
+	if *dealFile == "" {
+		return cards.DealRecord{}, "", fmt.Errorf("give a -game or a -file to analyze")
+	}
+	deals, err := cards.ReadDealFile(*dealFile)
+	if err != nil {
+		return cards.DealRecord{}, "", err
+	}
+	for _, d := range deals {
+		if *board == 0 || d.Board == *board {
+			return d, fmt.Sprintf("%s board %d", *dealFile, d.Board), nil
+		}
+	}
+	return cards.DealRecord{}, "", fmt.Errorf("no board %d in %s", *board, *dealFile)
+}
@@ -13,6 +13,8 @@ import (
 
 var (
 	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
+	dealFile   = flag.String("deal", "", "PBN file of starting hands to play instead of a random deal")
+	board      = flag.Int("board", 0, "Board number to play from the -deal file (default first)")
 	playerType = "basic"
 	serverType = "inprocess"
 )
@@ -38,7 +40,15 @@ func runPlayers() error {
 	if err != nil {
 		return fmt.Errorf("couldn't connect to server: %w", err)
 	}
-	gameId, err := conn.CreateGame(context.Background())
+	var opts []client.GameOption
+	if *dealFile != "" {
+		deal, err := client.DealFromFile(*dealFile, *board)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, deal)
+	}
+	gameId, err := conn.CreateGame(context.Background(), opts...)
 	if err != nil {
 		return err
 	}
@@ -1,12 +1,26 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
 
 	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
 	"golang.org/x/exp/slices"
 )
 
+var (
+	numDeals = flag.Int("n", 0, "Number of random deals to write in PBN format instead of playing a trick")
+	outFile  = flag.String("o", "", "File to write deals to (default stdout)")
+	event    = flag.String("event", "", "Event name to record with the deals")
+	analyze  = flag.String("analyze", "", "PBN file of played deals to check for endgame mistakes")
+	endgame  = flag.Int("endgame", 4, "Number of final tricks to check with -analyze")
+)
+
 //func (g *Game) PlayTrick() Trick {
 
 //}
@@ -31,6 +45,19 @@ func NewGame(playerNames ...string) *Game {
 }
 
 func main() {
+	flag.Parse()
+	if *analyze != "" {
+		if err := analyzeDeals(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *numDeals > 0 {
+		if err := writeDeals(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	g := NewGame("Joe", "Mary", "Bob", "Jill")
 	trick := cards.NewTrick()
 	for _, p := range g.players {
@@ -54,3 +81,47 @@ func main() {
 	}
 	fmt.Printf("Trick: %s\n", trick)
 }
+
+func writeDeals() error {
+	var w io.Writer = os.Stdout
+	if *outFile != "" {
+		f, err := os.Create(*outFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	date := time.Now().Format("2006.01.02")
+	var deals []cards.DealRecord
+	for i := 1; i <= *numDeals; i++ {
+		d := cards.NewRandomDeal(i)
+		d.Event = *event
+		d.Date = date
+		deals = append(deals, d)
+	}
+	return cards.WriteDeals(w, deals)
+}
+
+// Reports plays in the last tricks of each deal that a double-dummy solver could improve on.
+func analyzeDeals() error {
+	deals, err := cards.ReadDealFile(*analyze)
+	if err != nil {
+		return err
+	}
+	for _, d := range deals {
+		if len(d.Tricks) == 0 {
+			continue
+		}
+		mistakes, err := hearts.ReviewEndgame(d, *endgame)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Board %d: %d endgame mistakes\n", d.Board, len(mistakes))
+		for _, m := range mistakes {
+			fmt.Printf("  Trick %d: %s played %s (%d points), best was %s (%d points)\n",
+				m.Trick, m.Seat.Name(), m.Played.Card, m.Played.Score, m.Best.Card, m.Best.Score)
+		}
+	}
+	return nil
+}
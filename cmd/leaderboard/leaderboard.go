@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+var (
+	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
+	playerName = flag.String("player", "", "Show this player's statistics instead of the leaderboard")
+	order      = flag.String("order", "wins", "How to rank players, one of wins, score (lowest average hand score), games, moons or rating")
+	minGames   = flag.Int("mingames", 1, "Rank only players who completed at least this many games")
+	limit      = flag.Int("n", 10, "Number of players to show (0 for all)")
+	serverType = "lan"
+)
+
+var orders = map[string]client.LeaderboardOrder{
+	"wins":   client.ByWinRate,
+	"score":  client.ByAverageHandScore,
+	"games":  client.ByGamesPlayed,
+	"moons":  client.ByMoonShots,
+	"rating": client.ByRating,
+}
+
+func init() {
+	client.AddServerFlag(&serverType, "server")
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	stype, err := client.ServerTypeFromFlag(serverType)
+	if err != nil {
+		return err
+	}
+	conn, err := client.Connect(stype, *verbose)
+	if err != nil {
+		return fmt.Errorf("couldn't connect to server: %w", err)
+	}
+	defer conn.Close()
+	ctx := context.Background()
+	if *playerName != "" {
+		stats, err := conn.GetPlayerStats(ctx, *playerName)
+		if err != nil {
+			return err
+		}
+		fmt.Println(stats)
+		return nil
+	}
+	o, ok := orders[*order]
+	if !ok {
+		return fmt.Errorf("unknown -order %q", *order)
+	}
+	players, err := conn.GetLeaderboard(ctx, client.LeaderboardOptions{Order: o, MinGames: *minGames, Limit: *limit})
+	if err != nil {
+		return err
+	}
+	if len(players) == 0 {
+		fmt.Println("No completed games")
+		return nil
+	}
+	fmt.Printf("%4s %-20s %6s %6s %6s %6s %9s %6s %6s\n", "", "Player", "Rating", "Games", "Wins", "Win %", "Avg score", "Moons", "Q♠")
+	for i, ps := range players {
+		fmt.Printf("%4d %-20s %6.0f %6d %6d %5.0f%% %9.1f %6d %6d\n",
+			i+1, ps.Name, ps.Rating, ps.GamesPlayed, ps.Wins, 100*ps.WinRate(), ps.AverageHandScore(), ps.MoonShots, ps.QueenOfSpadesTaken)
+	}
+	return nil
+}
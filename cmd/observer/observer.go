@@ -6,17 +6,25 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
 )
 
 var (
 	//	logger     = log.New(os.Stdout, "", 0)
 	gameId     = flag.String("game", "", "Game to observe")
+	joinCode   = flag.String("code", "", "With -game, the join code of the game, if it has one")
 	all        = flag.Bool("all", false, "Observe all games")
 	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
 	name       = flag.String("name", "", "Your observer name")
+	evaluate   = flag.Bool("evaluate", false, "Show the expected points for each of the next player's legal plays")
+	past       = flag.Bool("past", false, "List games that are over. With -game, print that game's history")
+	playerName = flag.String("player", "", "With -past, list only games this player played in")
+	since      = flag.String("since", "", "With -past, list only games started on or after this date (YYYY-MM-DD)")
+	until      = flag.String("until", "", "With -past, list only games started before this date (YYYY-MM-DD)")
 	serverType = "lan"
 )
 
@@ -42,6 +50,12 @@ func observe() error {
 	}
 	ctx := context.Background()
 	wg := new(sync.WaitGroup)
+	if *past {
+		if *gameId != "" {
+			return showHistory(conn, *gameId)
+		}
+		return showPastGames(conn)
+	}
 	if *all {
 		rc := &registryCallbacks{wg: wg}
 		_, err := conn.RegisterObserver(ctx, wg, *name, rc, gameCallbacks{})
@@ -54,8 +68,12 @@ func observe() error {
 		return nil
 	} else {
 		// Observe one game.
-		gameState, err := conn.GetGameState(ctx, *gameId)
+		gameState, err := conn.GetGameStateWithCode(ctx, *gameId, *joinCode)
 		if err != nil {
+			// It may have been deleted once it was over.
+			if herr := showHistory(conn, *gameId); herr == nil {
+				return nil
+			}
 			return fmt.Errorf("Couldn't get gamestate: %w", err)
 		}
 		if gameState.Phase == client.Completed || gameState.Phase == client.Aborted {
@@ -67,7 +85,7 @@ func observe() error {
 		if err != nil {
 			return fmt.Errorf("Couldn't register with server: %w", err)
 		}
-		err = session.ObserveGame(ctx, wg, *gameId)
+		err = session.ObserveGameWithCode(ctx, wg, *gameId, *joinCode)
 		if err != nil {
 			return fmt.Errorf("Couldn't observe game %s: %w", *gameId, err)
 		}
@@ -89,8 +107,56 @@ func showGames(conn client.Connection) {
 	}
 	fmt.Printf("Available games\n")
 	for _, g := range games {
-		fmt.Printf("%s - %s %s\n", g.Id, g.Phase, g.Names)
+		fmt.Printf("%s - %s %s", g.Id, g.Phase, g.Names)
+		if len(g.Ratings) > 0 {
+			fmt.Printf(" average rating %.0f", g.AverageRating())
+		}
+		fmt.Println()
+	}
+}
+
+func showHistory(conn client.Connection, gameId string) error {
+	h, err := conn.GetGameHistory(context.Background(), gameId)
+	if err != nil {
+		return fmt.Errorf("Couldn't get history of game %s: %w", gameId, err)
 	}
+	fmt.Print(h)
+	return nil
+}
+
+func showPastGames(conn client.Connection) error {
+	filter := client.PastGamesFilter{PlayerName: *playerName}
+	var err error
+	if filter.StartedAfter, err = parseDate(*since); err != nil {
+		return err
+	}
+	if filter.StartedBefore, err = parseDate(*until); err != nil {
+		return err
+	}
+	games, err := conn.ListPastGames(context.Background(), filter)
+	if err != nil {
+		return fmt.Errorf("Couldn't list past games: %w", err)
+	}
+	if len(games) == 0 {
+		fmt.Printf("No past games\n")
+		return nil
+	}
+	for _, g := range games {
+		fmt.Printf("%s - %s %s %s", g.Id, g.Start.Format("2006-01-02 15:04"), g.Phase, g.Names)
+		if len(g.HandScores) > 0 {
+			fmt.Printf(" scores %v", g.HandScores)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// Parses a YYYY-MM-DD date in local time. An empty date is the zero time.
+func parseDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation("2006-01-02", date, time.Local)
 }
 
 // client.RegistryCallbacks
@@ -155,7 +221,10 @@ func (gameCallbacks) HandlePlayerLeft(s client.Session, name string, gameId stri
 }
 func (c gameCallbacks) HandleGameStarted(s client.Session, gameId string) error {
 	fmt.Printf("Game %s started\n", gameId)
-	return nil
+	return c.showEvaluation(s, gameId)
+}
+func (c gameCallbacks) HandleCardPlayed(s client.Session, gameId string, card cards.Card, playerId, playerName string) error {
+	return c.showEvaluation(s, gameId)
 }
 func (c gameCallbacks) HandleGameFinished(s client.Session, gameId string) {
 	fmt.Printf("Game %s over\n", gameId)
@@ -176,6 +245,25 @@ func (c gameCallbacks) showGameState(s client.Session, gameId string) {
 	fmt.Printf("%v\n", gameState)
 }
 
+// Shows how each of the next player's legal plays is expected to turn out for them.
+func (c gameCallbacks) showEvaluation(s client.Session, gameId string) error {
+	if !*evaluate {
+		return nil
+	}
+	gameState, err := s.GetGameState(context.Background(), gameId)
+	if err != nil {
+		return fmt.Errorf("couldn't get game state: %w", err)
+	}
+	view, ok := player.GameStateForNextPlayer(gameState)
+	if !ok {
+		return nil
+	}
+	evals := player.EvaluatePlays(view, player.DefaultEvaluationConfig)
+	fmt.Printf("%s %s to play %v:\n%s", gameId, view.Players[0].Name, view.CurrentTrick,
+		player.FormatPlayEvaluations(evals, cards.DisplayNotation))
+	return nil
+}
+
 func (c gameCallbacks) HandleTrickCompleted(s client.Session, gameId string,
 	trick cards.Cards, winningCard cards.Card, trickWinnerId, trickWinnerName string) error {
 	fmt.Printf("%s trick: %v - winner %s\n", gameId, trick, winningCard)
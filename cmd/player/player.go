@@ -5,8 +5,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
 	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
 )
@@ -14,20 +18,34 @@ import (
 var (
 	gameId     = flag.String("game", "", "Game to join")
 	joinAny    = flag.Bool("joinany", false, "Join any available game")
+	queue      = flag.Bool("queue", false, "Wait in the server's queue to be matched with other players")
+	ratingGap  = flag.Float64("ratinggap", 0, "With -queue, only play with players whose ratings are within this much of yours (default any)")
+	private    = flag.Bool("private", false, "Create a private game that you host, hidden from the games list")
+	joinCode   = flag.String("code", "", "Code that players need to join the game, when creating it with -private or joining it with -game")
+	reserve    = flag.String("reserve", "", "With -private, comma-separated names of players to keep seats for")
+	partners   = flag.Bool("partners", false, "With -private, make players sitting across from each other partners")
+	seat       = flag.String("seat", "", "Seat to take once you've joined, one of north, east, south or west (default the first empty seat)")
 	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
 	name       = flag.String("name", "", "Your player name")
 	hints      = flag.Bool("hints", false, "Provide gameplay hints")
 	playerType = "basic"
 	serverType = "lan"
+	botTypes   []string
 )
 
 func init() {
 	hearts.AddPlayerFlag(&playerType, "player")
 	client.AddServerFlag(&serverType, "server")
+	flag.Func("addbot", "Player type of a bot for the server to add to the game, e.g. expert; repeat for more bots",
+		func(flagValue string) error {
+			botTypes = append(botTypes, flagValue)
+			return nil
+		})
 }
 
 func main() {
 	flag.Parse()
+	cards.DisplayNotation = cards.TerminalNotation(os.Stdout)
 	err := runPlayer()
 	if err != nil {
 		fmt.Printf("%v\n", err)
@@ -42,7 +60,7 @@ func runPlayer() error {
 	if err != nil {
 		return err
 	}
-	if *gameId == "" {
+	if *gameId == "" && !*queue && !*private {
 		if *joinAny {
 			*gameId, err = chooseGame(conn)
 			if err != nil {
@@ -62,16 +80,89 @@ func runPlayer() error {
 		return err
 	}
 	wg := new(sync.WaitGroup)
-	err = session.JoinGame(ctx, wg, *gameId)
-	if err != nil {
-		return err
+	if *queue {
+		fmt.Printf("Waiting for players\n")
+		opts := client.QueueOptions{
+			MaxRatingGap: *ratingGap,
+			Waiting: func(players, seats int) {
+				fmt.Printf("%d of %d players waiting\n", players, seats)
+			},
+		}
+		if *gameId, err = session.JoinQueue(ctx, wg, opts); err != nil {
+			return err
+		}
+	} else {
+		if *private {
+			if *gameId, err = createPrivateGame(ctx, conn, session); err != nil {
+				return err
+			}
+		}
+		if err = session.JoinGameWithCode(ctx, wg, *gameId, *joinCode); err != nil {
+			return err
+		}
 	}
 	fmt.Printf("Joined game %s\n", *gameId)
+	if *seat != "" {
+		if err := chooseSeat(ctx, session, *gameId, *seat); err != nil {
+			return fmt.Errorf("couldn't take seat %s: %w", *seat, err)
+		}
+	}
+	for _, botType := range botTypes {
+		if err := session.AddBot(ctx, *gameId, botType); err != nil {
+			return fmt.Errorf("couldn't add %s bot: %w", botType, err)
+		}
+	}
 	wg.Wait()
 	fmt.Printf("Game %s finished\n", *gameId)
 	return nil
 }
 
+// Creates a private game hosted by session.
+func createPrivateGame(ctx context.Context, conn client.Connection, session client.Session) (string, error) {
+	opts := []client.GameOption{client.Private(), client.HostedBy(session)}
+	if *joinCode != "" {
+		opts = append(opts, client.WithJoinCode(*joinCode))
+	}
+	if *partners {
+		opts = append(opts, client.WithPartnerships())
+	}
+	if *reserve != "" {
+		opts = append(opts, client.WithReservedSeats(strings.Split(*reserve, ",")...))
+	}
+	gameId, err := conn.CreateGame(ctx, opts...)
+	if err != nil {
+		return "", fmt.Errorf("couldn't create game: %w", err)
+	}
+	if *joinCode != "" {
+		fmt.Printf("Created private game %s with join code %s\n", gameId, *joinCode)
+	} else {
+		fmt.Printf("Created private game %s\n", gameId)
+	}
+	return gameId, nil
+}
+
+// Moves to the seat named seatName once session is seated in gameId.
+func chooseSeat(ctx context.Context, session client.Session, gameId, seatName string) error {
+	seat, err := cards.ParseSeat(seatName)
+	if err != nil {
+		return err
+	}
+	// Joining finishes in the background.
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		gs, err := session.GetGameState(ctx, gameId)
+		if err != nil {
+			return err
+		}
+		if gs.Phase != client.Preparing {
+			return fmt.Errorf("game %s has started", gameId)
+		}
+		if _, err := gs.GetPlayerState(session.GetSessionId()); err == nil {
+			return session.ChooseSeat(ctx, gameId, seat)
+		}
+	}
+	return fmt.Errorf("not seated in game %s", gameId)
+}
+
 func showGames(conn client.Connection) error {
 	ctx := context.Background()
 	games, err := conn.ListGames(ctx, client.Preparing)
@@ -119,10 +210,12 @@ func (c callbacks) HandleGameStarted(s client.Session, gameId string) error {
 func (c callbacks) HandleGameFinished(s client.Session, gameId string) {
 	fmt.Printf("Game %s over\n", gameId)
 	showGameState(s, gameId)
+	c.GameCallbacks.HandleGameFinished(s, gameId)
 }
 func (c callbacks) HandleGameAborted(s client.Session, gameId string) {
 	fmt.Printf("Game %s aborted\n", gameId)
 	showGameState(s, gameId)
+	c.GameCallbacks.HandleGameAborted(s, gameId)
 }
 func (c callbacks) HandleConnectionError(s client.Session, err error) {
 	fmt.Printf("%v\n", err)
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
+	"github.com/mpsalisbury/cards/pkg/server"
+)
+
+var (
+	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
+	gameId     = flag.String("game", "", "Past game to replay, from the server")
+	dealFile   = flag.String("file", "", "PBN file with the deal to replay, or a .history file kept by a server")
+	board      = flag.Int("board", 0, "Board number to replay from the -file (default first)")
+	start      = flag.Int("start", 0, "Number of plays to show played at the start")
+	name       = flag.String("name", "", "Your player name, when continuing play")
+	hints      = flag.Bool("hints", false, "Provide gameplay hints when continuing play")
+	playerType = "basic"
+	serverType = "lan"
+)
+
+func init() {
+	player.AddPlayerFlag(&playerType, "player")
+	client.AddServerFlag(&serverType, "server")
+}
+
+func main() {
+	flag.Parse()
+	cards.DisplayNotation = cards.TerminalNotation(os.Stdout)
+	d, err := readDeal()
+	if err != nil {
+		log.Fatal(err)
+	}
+	r := &replay{deal: d}
+	for _, t := range d.Tricks {
+		r.plays = append(r.plays, t.Cards...)
+	}
+	if err := r.goTo(*start); err != nil {
+		log.Fatal(err)
+	}
+	if err := r.run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Reads the deal to replay from the server or a file.
+func readDeal() (cards.DealRecord, error) {
+	switch {
+	case *gameId != "":
+		stype, err := client.ServerTypeFromFlag(serverType)
+		if err != nil {
+			return cards.DealRecord{}, err
+		}
+		conn, err := client.Connect(stype, *verbose)
+		if err != nil {
+			return cards.DealRecord{}, fmt.Errorf("couldn't connect to server: %w", err)
+		}
+		defer conn.Close()
+		h, err := conn.GetGameHistory(context.Background(), *gameId)
+		if err != nil {
+			return cards.DealRecord{}, fmt.Errorf("couldn't get game %s: %w", *gameId, err)
+		}
+		return h.DealRecord(), nil
+	case strings.HasSuffix(*dealFile, ".history"):
+		h, err := client.ReadHistoryFile(*dealFile)
+		if err != nil {
+			return cards.DealRecord{}, err
+		}
+		return h.DealRecord(), nil
+	case *dealFile != "":
+		deals, err := cards.ReadDealFile(*dealFile)
+		if err != nil {
+			return cards.DealRecord{}, err
+		}
+		for _, d := range deals {
+			if *board == 0 || d.Board == *board {
+				return d, nil
+			}
+		}
+		return cards.DealRecord{}, fmt.Errorf("no board %d in %s", *board, *dealFile)
+	}
+	return cards.DealRecord{}, fmt.Errorf("give a -game or a -file to replay")
+}
+
+// Steps through a recorded deal.
+type replay struct {
+	deal  cards.DealRecord
+	plays cards.Cards // Every card played, in order.
+	n     int         // Number of plays shown played.
+	pos   *hearts.Position
+}
+
+const help = `Commands:
+  n (or Enter)  next card       p  previous card
+  t             next trick      b  back a trick
+  g <play>      go to after that many plays
+  c [seat]      continue play from here against bots, playing seat (default the next to play)
+  q             quit
+`
+
+func (r *replay) run() error {
+	fmt.Print(help)
+	in := bufio.NewScanner(os.Stdin)
+	for {
+		r.show()
+		fmt.Print("> ")
+		if !in.Scan() {
+			return in.Err()
+		}
+		fields := strings.Fields(in.Text())
+		cmd := ""
+		if len(fields) > 0 {
+			cmd = fields[0]
+		}
+		var err error
+		switch cmd {
+		case "", "n":
+			err = r.goTo(r.n + 1)
+		case "p":
+			err = r.goTo(r.n - 1)
+		case "t":
+			err = r.goTo(r.n/4*4 + 4)
+		case "b":
+			err = r.goTo((r.n - 1) / 4 * 4)
+		case "g":
+			if len(fields) != 2 {
+				err = fmt.Errorf("go to which play?")
+				break
+			}
+			var n int
+			if n, err = strconv.Atoi(fields[1]); err == nil {
+				err = r.goTo(n)
+			}
+		case "c":
+			seat := cards.Seat(r.pos.NextSeat())
+			if len(fields) > 1 {
+				if seat, err = cards.ParseSeat(fields[1]); err != nil {
+					break
+				}
+			}
+			return r.continuePlay(seat)
+		case "q":
+			return nil
+		default:
+			fmt.Print(help)
+		}
+		if err != nil {
+			fmt.Printf("%v\n", err)
+		}
+	}
+}
+
+// Shows the deal after its first n plays.
+func (r *replay) goTo(n int) error {
+	if n < 0 || n > len(r.plays) {
+		return fmt.Errorf("can go to plays 0 to %d", len(r.plays))
+	}
+	pos, err := hearts.PositionFromDeal(r.deal, n)
+	if err != nil {
+		return err
+	}
+	r.n = n
+	r.pos = pos
+	return nil
+}
+
+func (r *replay) seatName(s cards.Seat) string {
+	if name := r.deal.Names[s]; name != "" {
+		return fmt.Sprintf("%s (%s)", s.Name(), name)
+	}
+	return s.Name()
+}
+
+func (r *replay) show() {
+	n := cards.DisplayNotation
+	pos := r.pos
+	fmt.Printf("\nAfter play %d of %d", r.n, len(r.plays))
+	if r.n > 0 {
+		fmt.Printf(" (trick %d, card %d)", (r.n-1)/4+1, (r.n-1)%4+1)
+	}
+	fmt.Println()
+	for _, s := range cards.Seats {
+		fmt.Printf("%-16s %2d points  %s\n", r.seatName(s), pos.Points[s], n.Hand(pos.Hands[s]))
+	}
+	if r.n > 0 && len(pos.Trick) == 0 {
+		t := r.deal.Tricks[r.n/4-1]
+		fmt.Printf("Last trick: %s - won by %s\n", r.trickString(t), r.seatName(t.Winner()))
+	}
+	if len(pos.Trick) > 0 {
+		t := cards.PlayedTrick{Leader: cards.Seat(pos.Leader), Cards: pos.Trick}
+		fmt.Printf("Trick: %s\n", r.trickString(t))
+	}
+	if r.n == len(r.plays) {
+		if len(r.deal.Scores) > 0 {
+			fmt.Printf("Hand scores: %v\n", r.deal.Scores)
+		}
+		return
+	}
+	fmt.Printf("%s to play\n", r.seatName(cards.Seat(pos.NextSeat())))
+}
+
+func (r *replay) trickString(t cards.PlayedTrick) string {
+	var plays []string
+	for i, c := range t.Cards {
+		plays = append(plays, fmt.Sprintf("%s %s", t.Seat(i), cards.DisplayNotation.Card(c)))
+	}
+	return strings.Join(plays, ", ")
+}
+
+// Continues the deal from the current play in a game on an in-process server,
+// with you playing seat and bots playing the rest.
+func (r *replay) continuePlay(seat cards.Seat) error {
+	if r.n == 52 {
+		return fmt.Errorf("every card has been played")
+	}
+	// You join first, so you're dealt the first hand. Seats follow in turn order.
+	var hands []cards.Cards
+	for i := 0; i < 4; i++ {
+		hands = append(hands, r.deal.Hands[seat.Next(i)])
+	}
+	if !*verbose {
+		// The server logs every trick.
+		log.SetOutput(io.Discard)
+	}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{}), *verbose)
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx, client.WithDeal(hands), client.WithPlays(r.plays[:r.n]))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Continuing as %s\n", seat.Name())
+	wg := new(sync.WaitGroup)
+	if err := startPlayer(conn, wg, *name, gameId, player.NewTerminalPlayer(*hints)); err != nil {
+		return err
+	}
+	if err := waitForPlayers(conn, gameId, 1); err != nil {
+		return err
+	}
+	for i := 0; i < 3; i++ {
+		bot, err := player.NewPlayerFromFlag(playerType, false)
+		if err != nil {
+			return fmt.Errorf("couldn't create player: %w", err)
+		}
+		if err := startPlayer(conn, wg, "", gameId, bot); err != nil {
+			return err
+		}
+	}
+	wg.Wait()
+	gameState, err := conn.GetGameState(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	fmt.Print(gameState)
+	return nil
+}
+
+func startPlayer(conn client.Connection, wg *sync.WaitGroup, name string, gameId string, p client.GameCallbacks) error {
+	ctx := context.Background()
+	session, err := conn.Register(ctx, name, p)
+	if err != nil {
+		return fmt.Errorf("couldn't register with server: %w", err)
+	}
+	if err := session.JoinGame(ctx, wg, gameId); err != nil {
+		return fmt.Errorf("couldn't join game: %w", err)
+	}
+	return nil
+}
+
+// Waits until n players have joined the game.
+func waitForPlayers(conn client.Connection, gameId string, n int) error {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		games, err := conn.ListGames(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, g := range games {
+			if g.Id == gameId && len(g.Names) >= n {
+				return nil
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("couldn't take a seat in game %s", gameId)
+}
@@ -6,20 +6,39 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/mpsalisbury/cards/pkg/discovery"
+	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
 	pb "github.com/mpsalisbury/cards/pkg/proto"
 	"github.com/mpsalisbury/cards/pkg/server"
 )
 
 var (
-	advertise = flag.Bool("advertise", false, "Advertise service on LAN")
+	advertise      = flag.Bool("advertise", false, "Advertise service on LAN")
+	replacementBot = flag.String("replacementbot", "basic", "Player type of the bot that takes over from a player who leaves during a game (empty aborts the game instead)")
+	saveDir        = flag.String("savedir", "", "Directory to save games in, so they survive restarts (default don't save)")
+	historyDir     = flag.String("historydir", "", "Directory to keep the history of games in (default keep it in memory)")
+	ratingsFile    = flag.String("ratingsfile", "", "File to keep players' skill ratings in (default keep them in memory)")
+	queueBot       = flag.String("queuebot", "basic", "Player type of the bots that fill queued tables that aren't full in time (empty waits for people)")
+	queueWait      = flag.Duration("queuewait", 30*time.Second, "How long a queued table waits for people before it's filled with bots")
+	queueJoinWait  = flag.Duration("queuejoinwait", time.Minute, "How long queued players have to join the game they're given before their seats are freed")
+	resumeGrace    = flag.Duration("resumegrace", time.Minute, "How long a player whose connection drops has to reconnect before they're treated as having left")
 )
 
 func main() {
 	flag.Parse()
+	bots := hearts.NewBotHost()
+	for flagName, playerType := range map[string]string{"replacementbot": *replacementBot, "queuebot": *queueBot} {
+		if playerType == "" {
+			continue
+		}
+		if err := bots.CheckPlayerType(playerType); err != nil {
+			log.Fatalf("-%s: %v", flagName, err)
+		}
+	}
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "50051"
@@ -42,8 +61,32 @@ func main() {
 		defer ad.Close()
 	}
 
+	opts := server.Options{
+		Bots:              bots,
+		Analyzer:          hearts.NewGameAnalyzer(),
+		ReplacementBot:    *replacementBot,
+		ResumeGracePeriod: *resumeGrace,
+		QueueBot:          *queueBot,
+		QueueWait:         *queueWait,
+		QueueJoinWait:     *queueJoinWait,
+	}
+	if *saveDir != "" {
+		if opts.Store, err = server.NewFileStore(*saveDir); err != nil {
+			log.Fatalf("NewFileStore: %v", err)
+		}
+	}
+	if *historyDir != "" {
+		if opts.History, err = server.NewFileHistory(*historyDir); err != nil {
+			log.Fatalf("NewFileHistory: %v", err)
+		}
+	}
+	if *ratingsFile != "" {
+		if opts.Ratings, err = server.NewFileRatings(*ratingsFile); err != nil {
+			log.Fatalf("NewFileRatings: %v", err)
+		}
+	}
 	grpcServer := grpc.NewServer()
-	pb.RegisterCardGameServiceServer(grpcServer, server.NewCardGameService())
+	pb.RegisterCardGameServiceServer(grpcServer, server.NewCardGameService(opts))
 	if err = grpcServer.Serve(listener); err != nil {
 		log.Fatal(err)
 	}
@@ -6,15 +6,19 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
 	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
 )
 
 var (
 	verbose    = flag.Bool("verbose", false, "Print extra information during the session")
+	dealFile   = flag.String("deal", "", "PBN file of starting hands to play instead of a random deal")
+	board      = flag.Int("board", 0, "Board number to play from the -deal file (default first)")
 	name       = flag.String("name", "", "Your player name")
 	hints      = flag.Bool("hints", false, "Provide gameplay hints")
 	playerType = "basic"
@@ -28,6 +32,7 @@ func init() {
 }
 func main() {
 	flag.Parse()
+	cards.DisplayNotation = cards.TerminalNotation(os.Stdout)
 	err := runPlayer()
 	if err != nil {
 		log.Fatal(err)
@@ -42,7 +47,15 @@ func runPlayer() error {
 	if err != nil {
 		return fmt.Errorf("couldn't connect to server: %w", err)
 	}
-	gameId, err := conn.CreateGame(context.Background())
+	var opts []client.GameOption
+	if *dealFile != "" {
+		deal, err := client.DealFromFile(*dealFile, *board)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, deal)
+	}
+	gameId, err := conn.CreateGame(context.Background(), opts...)
 	if err != nil {
 		return err
 	}
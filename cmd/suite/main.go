@@ -0,0 +1,54 @@
+// Command suite plays a strategy against a suite of positions with known good plays
+// and reports which it got wrong, e.g.
+//
+//	suite -player basic
+//	suite -player mc -file my.positions -verbose
+//
+// Without -file it uses the built-in suite, which covers each branch of basic strategy.
+// It exits with status 1 if any position fails.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/suite"
+)
+
+var (
+	playerType = "basic"
+	suiteFile  = flag.String("file", "", "Suite file of positions to play (default the built-in suite)")
+	verbose    = flag.Bool("verbose", false, "Show every position, not just the failures")
+)
+
+func init() {
+	flag.Func("player", "Player type to test (default basic)", func(flagValue string) error {
+		if _, err := hearts.NewStrategyFromFlag(flagValue); err != nil {
+			return err
+		}
+		playerType = flagValue
+		return nil
+	})
+}
+
+func main() {
+	flag.Parse()
+	positions, err := suite.ReadSuiteFile(*suiteFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	results, err := suite.Run(positions, func() (hearts.PlayerStrategy, error) {
+		return hearts.NewStrategyFromFlag(playerType)
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := suite.WriteReport(os.Stdout, results, *verbose); err != nil {
+		log.Fatal(err)
+	}
+	if suite.Score(results) < 1 {
+		os.Exit(1)
+	}
+}
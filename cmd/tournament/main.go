@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/tournament"
+)
+
+var (
+	numDeals  = flag.Int("deals", 1000, "Number of random deals to play")
+	duplicate = flag.Bool("duplicate", true, "Play every deal once for each rotation of the lineup")
+	dealFile  = flag.String("deal", "", "PBN file of deals to play instead of random deals")
+	parallel  = flag.Int("parallel", 0, "Number of hands to play at once (default one per CPU)")
+	seed      = flag.Int64("seed", 0, "Seed for the random deals (default based on the time)")
+	jsonFile  = flag.String("json", "", "File to write the report to as JSON (- for stdout)")
+	lineup    []string
+)
+
+func init() {
+	flag.Func("player", "Player type for the next seat; repeat for up to 4 seats, which are filled by repeating the lineup (e.g. -player mc -player basic)",
+		func(flagValue string) error {
+			if _, err := hearts.NewStrategyFromFlag(flagValue); err != nil {
+				return err
+			}
+			lineup = append(lineup, flagValue)
+			return nil
+		})
+}
+
+func main() {
+	flag.Parse()
+	if err := runTournament(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runTournament() error {
+	if len(lineup) == 0 {
+		lineup = []string{"tracker", "basic"}
+	}
+	config := tournament.Config{
+		Lineup:    lineup,
+		NumDeals:  *numDeals,
+		Duplicate: *duplicate,
+		Parallel:  *parallel,
+		Seed:      *seed,
+	}
+	if config.Seed == 0 {
+		config.Seed = time.Now().UnixNano()
+	}
+	if *dealFile != "" {
+		deals, err := cards.ReadDealFile(*dealFile)
+		if err != nil {
+			return err
+		}
+		config.Deals = deals
+	}
+	start := time.Now()
+	report, err := tournament.Run(context.Background(), config)
+	if err != nil {
+		return err
+	}
+	if *jsonFile == "-" {
+		return report.WriteJSON(os.Stdout)
+	}
+	fmt.Printf("Played %d hands in %s\n", report.NumHands, time.Since(start).Round(time.Millisecond))
+	if err := report.WriteTable(os.Stdout); err != nil {
+		return err
+	}
+	if *jsonFile != "" {
+		f, err := os.Create(*jsonFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return report.WriteJSON(f)
+	}
+	return nil
+}
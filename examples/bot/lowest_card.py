@@ -0,0 +1,35 @@
+#!/usr/bin/env python3
+"""A hearts bot that always plays its lowest legal card.
+
+Run it with -player exec:examples/bot/lowest_card.py. See BotStrategy in
+pkg/game/hearts/player/bot.go for a description of the protocol.
+"""
+
+import json
+import sys
+
+VALUES = "23456789TJQKA"
+
+
+def send(message):
+    print(json.dumps(message), flush=True)
+
+
+def choose_card(state):
+    return min(state["legal_plays"], key=lambda card: VALUES.index(card[0]))
+
+
+def main():
+    for line in sys.stdin:
+        message = json.loads(line)
+        kind = message["type"]
+        if kind == "hello":
+            send({"type": "hello", "name": "Lowest Card"})
+        elif kind == "your_turn":
+            send({"type": "play", "card": choose_card(message["state"])})
+        elif kind == "quit":
+            break
+
+
+if __name__ == "__main__":
+    main()
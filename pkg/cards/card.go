@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"unicode"
 )
 
 // A card's suit.
@@ -37,15 +38,31 @@ func (s Suit) String() string {
 	panic("Unknown Suit")
 }
 
+// The suit's name in English, e.g. "clubs".
+func (s Suit) Name() string {
+	switch s {
+	case Clubs:
+		return "clubs"
+	case Hearts:
+		return "hearts"
+	case Spades:
+		return "spades"
+	case Diamonds:
+		return "diamonds"
+	}
+	panic("Unknown Suit")
+}
+
+// Accepts a suit letter, a Unicode suit symbol (filled or outlined), or the suit's name.
 func parseSuit(s string) (Suit, error) {
 	switch strings.ToLower(s) {
-	case "c":
+	case "c", "♣", "♧", "club", "clubs":
 		return Clubs, nil
-	case "h":
+	case "h", "♥", "♡", "heart", "hearts":
 		return Hearts, nil
-	case "s":
+	case "s", "♠", "♤", "spade", "spades":
 		return Spades, nil
-	case "d":
+	case "d", "♦", "♢", "diamond", "diamonds":
 		return Diamonds, nil
 	}
 	return Clubs, fmt.Errorf("no such suit '%s'", s)
@@ -118,33 +135,34 @@ func (v Value) String() string {
 	panic("Unknown Value")
 }
 
+// Accepts a value character, "10" for ten, or the value's name.
 func parseValue(v string) (Value, error) {
 	switch strings.ToLower(v) {
-	case "2":
+	case "2", "two":
 		return Two, nil
-	case "3":
+	case "3", "three":
 		return Three, nil
-	case "4":
+	case "4", "four":
 		return Four, nil
-	case "5":
+	case "5", "five":
 		return Five, nil
-	case "6":
+	case "6", "six":
 		return Six, nil
-	case "7":
+	case "7", "seven":
 		return Seven, nil
-	case "8":
+	case "8", "eight":
 		return Eight, nil
-	case "9":
+	case "9", "nine":
 		return Nine, nil
-	case "t":
+	case "t", "10", "ten":
 		return Ten, nil
-	case "j":
+	case "j", "jack":
 		return Jack, nil
-	case "q":
+	case "q", "queen":
 		return Queen, nil
-	case "k":
+	case "k", "king":
 		return King, nil
-	case "a":
+	case "a", "ace":
 		return Ace, nil
 	}
 	return Two, fmt.Errorf("no such value '%s'", v)
@@ -166,12 +184,28 @@ func ParseCardOrDie(cs string) Card {
 	}
 	return c
 }
+
+// Parses a card written in any of the common notations:
+// "Th", "10h", "10♥", "Q♠", "♠Q" or "queen of spades". Case is ignored.
 func ParseCard(c string) (Card, error) {
-	if len(c) != 2 {
+	cs := strings.TrimSpace(c)
+	if value, suit, found := strings.Cut(strings.ToLower(cs), " of "); found {
+		return parseCardParts(c, value, suit)
+	}
+	rs := []rune(cs)
+	if len(rs) < 2 {
 		return Card{}, fmt.Errorf("can't parse card '%s'", c)
 	}
-	v, verr := parseValue(c[0:1])
-	s, serr := parseSuit(c[1:2])
+	// Suit symbols may come before the value.
+	if _, err := parseSuit(string(rs[0])); err == nil && !unicode.IsLetter(rs[0]) {
+		return parseCardParts(c, string(rs[1:]), string(rs[0]))
+	}
+	return parseCardParts(c, string(rs[:len(rs)-1]), string(rs[len(rs)-1]))
+}
+
+func parseCardParts(c, value, suit string) (Card, error) {
+	v, verr := parseValue(strings.TrimSpace(value))
+	s, serr := parseSuit(strings.TrimSpace(suit))
 	if verr != nil || serr != nil {
 		return Card{}, fmt.Errorf("can't parse card '%s'", c)
 	}
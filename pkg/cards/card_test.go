@@ -23,6 +23,16 @@ func TestParseValidCard(t *testing.T) {
 		{"TS", Card{Ten, Spades}},
 		{"jH", Card{Jack, Hearts}},
 		{"ad", Card{Ace, Diamonds}},
+		{"10h", Card{Ten, Hearts}},
+		{"10♥", Card{Ten, Hearts}},
+		{"Q♠", Card{Queen, Spades}},
+		{"♠Q", Card{Queen, Spades}},
+		{"7♢", Card{Seven, Diamonds}},
+		{" 3c ", Card{Three, Clubs}},
+		{"queen of spades", Card{Queen, Spades}},
+		{"Ten of Hearts", Card{Ten, Hearts}},
+		{"2 of clubs", Card{Two, Clubs}},
+		{"ace of diamond", Card{Ace, Diamonds}},
 	}
 	for _, tc := range tests {
 		got, err := ParseCard(tc.c)
@@ -37,7 +47,7 @@ func TestParseValidCard(t *testing.T) {
 }
 
 func TestParseInvalidCard(t *testing.T) {
-	tests := []string{"xc", "7x", "2cc", "22c", "", "5"}
+	tests := []string{"xc", "7x", "2cc", "22c", "", "5", "11h", "♠", "queen of", "of spades", "queen spades"}
 	for _, tc := range tests {
 		got, err := ParseCard(tc)
 		if err == nil {
@@ -221,17 +221,9 @@ func (cs Cards) String() string {
 	return strings.Join(cardStrings, " ")
 }
 
+// Renders cards grouped by suit in the DisplayNotation.
 func (cs Cards) HandString() string {
-	cbs := cs.SplitBySuit()
-	suitStrings := []string{}
-	for _, s := range Suits {
-		scs := cbs[s]
-		if len(scs) > 0 {
-			scs.Sort()
-			suitStrings = append(suitStrings, scs.String())
-		}
-	}
-	return strings.Join(suitStrings, "   ")
+	return DisplayNotation.Hand(cs)
 }
 
 func ParseCards(cs []string) (Cards, error) {
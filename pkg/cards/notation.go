@@ -0,0 +1,112 @@
+package cards
+
+import (
+	"os"
+	"strings"
+)
+
+// Notation controls how cards are rendered for people to read.
+// Card.String and Cards.String always use the two-character ASCII form ("Th"),
+// which is also the form sent over the wire.
+type Notation struct {
+	Symbols bool // Show suits as ♣♥♠♦ and tens as "10".
+	Color   bool // Colour cards with ANSI escapes: red for hearts and diamonds, black otherwise.
+}
+
+var (
+	ASCIINotation   = Notation{}
+	UnicodeNotation = Notation{Symbols: true}
+	ColorNotation   = Notation{Symbols: true, Color: true}
+)
+
+// DisplayNotation is the notation used by HandString.
+// Programs that write to a terminal may set it with TerminalNotation.
+var DisplayNotation = ASCIINotation
+
+// Returns ColorNotation if f is a terminal, else ASCIINotation.
+func TerminalNotation(f *os.File) Notation {
+	if IsTerminal(f) {
+		return ColorNotation
+	}
+	return ASCIINotation
+}
+
+// Reports whether f is a character device such as a terminal.
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+func (s Suit) Symbol() string {
+	switch s {
+	case Clubs:
+		return "♣"
+	case Hearts:
+		return "♥"
+	case Spades:
+		return "♠"
+	case Diamonds:
+		return "♦"
+	}
+	panic("Unknown Suit")
+}
+
+func (s Suit) IsRed() bool {
+	return s == Hearts || s == Diamonds
+}
+
+const (
+	ansiRed   = "\x1b[31;47m"
+	ansiBlack = "\x1b[30;47m"
+	ansiReset = "\x1b[0m"
+)
+
+func (n Notation) Suit(s Suit) string {
+	if n.Symbols {
+		return s.Symbol()
+	}
+	return s.String()
+}
+
+func (n Notation) Value(v Value) string {
+	if n.Symbols && v == Ten {
+		return "10"
+	}
+	return v.String()
+}
+
+func (n Notation) Card(c Card) string {
+	cs := n.Value(c.Value) + n.Suit(c.Suit)
+	if !n.Color {
+		return cs
+	}
+	if c.Suit.IsRed() {
+		return ansiRed + cs + ansiReset
+	}
+	return ansiBlack + cs + ansiReset
+}
+
+func (n Notation) Cards(cs Cards) string {
+	cardStrings := []string{}
+	for _, c := range cs {
+		cardStrings = append(cardStrings, n.Card(c))
+	}
+	return strings.Join(cardStrings, " ")
+}
+
+// Renders cards sorted and grouped by suit.
+func (n Notation) Hand(cs Cards) string {
+	cbs := cs.SplitBySuit()
+	suitStrings := []string{}
+	for _, s := range Suits {
+		scs := cbs[s]
+		if len(scs) > 0 {
+			scs.Sort()
+			suitStrings = append(suitStrings, n.Cards(scs))
+		}
+	}
+	return strings.Join(suitStrings, "   ")
+}
@@ -0,0 +1,54 @@
+package cards
+
+import "testing"
+
+func TestNotationCard(t *testing.T) {
+	tests := []struct {
+		n    Notation
+		c    Card
+		want string
+	}{
+		{ASCIINotation, Cth, "Th"},
+		{ASCIINotation, Cqs, "Qs"},
+		{UnicodeNotation, Cth, "10♥"},
+		{UnicodeNotation, Cqs, "Q♠"},
+		{UnicodeNotation, C2c, "2♣"},
+		{UnicodeNotation, Cad, "A♦"},
+		{ColorNotation, Cqs, "\x1b[30;47mQ♠\x1b[0m"},
+		{ColorNotation, C7d, "\x1b[31;47m7♦\x1b[0m"},
+	}
+	for _, tc := range tests {
+		got := tc.n.Card(tc.c)
+		if got != tc.want {
+			t.Errorf("%+v.Card(%s)=%q, want %q", tc.n, tc.c, got, tc.want)
+		}
+	}
+}
+
+func TestNotationRoundTrip(t *testing.T) {
+	for _, n := range []Notation{ASCIINotation, UnicodeNotation} {
+		for _, c := range MakeDeck() {
+			got, err := ParseCard(n.Card(c))
+			if err != nil || got != c {
+				t.Errorf("ParseCard(%q)=%s,%v, want %s", n.Card(c), got, err, c)
+			}
+		}
+	}
+}
+
+func TestNotationHand(t *testing.T) {
+	hand := Cards{Cqs, C2c, Cth, C4s, Ckc}
+	tests := []struct {
+		n    Notation
+		want string
+	}{
+		{ASCIINotation, "2c Kc   Th   4s Qs"},
+		{UnicodeNotation, "2♣ K♣   10♥   4♠ Q♠"},
+	}
+	for _, tc := range tests {
+		got := tc.n.Hand(hand)
+		if got != tc.want {
+			t.Errorf("%+v.Hand(%s)=%q, want %q", tc.n, hand, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package cards
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Deal records in the style of Portable Bridge Notation (PBN), extended for hearts.
+//
+//	[Event "Friday night hearts"]
+//	[Site "?"]
+//	[Date "2023.10.20"]
+//	[Board "1"]
+//	[West "ann"]
+//	[North "bob"]
+//	[East "cat"]
+//	[South "dan"]
+//	[Dealer "N"]
+//	[Deal "N:AK2.Q43.T98.7654 ..."]
+//	[HeartsScore "3 13 0 10"]
+//	[Play "W"]
+//	C2 CK C5 CA
+//	...
+//	*
+//
+// Hands in the Deal tag start with the named seat and go clockwise, each written
+// as spades.hearts.diamonds.clubs. HeartsScore holds hand scores for N, E, S and W.
+// The Play section starts with the seat that led the first trick and has one line
+// per trick, with cards in seat order starting from that seat (not in order of play).
+// Records are separated by blank lines.
+
+// A DealRecord describes one hand: who held which cards and, optionally, how it was played and scored.
+type DealRecord struct {
+	Event  string
+	Site   string
+	Date   string
+	Board  int
+	Dealer Seat
+	Names  [4]string     // Player names by seat, may be empty.
+	Hands  [4]Cards      // Starting hands by seat.
+	Tricks []PlayedTrick // Optional play record, in order of play.
+	Scores []int         // Optional hand scores by seat.
+}
+
+// A trick in a DealRecord.
+type PlayedTrick struct {
+	Leader Seat
+	Cards  Cards // In order of play, starting with the Leader's card.
+}
+
+// Returns the seat that played the i'th card of this trick.
+func (t PlayedTrick) Seat(i int) Seat {
+	return t.Leader.Next(i)
+}
+
+// Returns the seat that took this trick: the highest card of the suit led.
+func (t PlayedTrick) Winner() Seat {
+	winningCard := t.Cards.LeadingCardOfTrick()
+	for i, c := range t.Cards {
+		if c == winningCard {
+			return t.Seat(i)
+		}
+	}
+	return t.Leader
+}
+
+// Deals a new random hand for the given board number. Like PBN boards, the dealer rotates clockwise from North.
+func NewRandomDeal(board int) DealRecord {
+	d := DealRecord{
+		Board:  board,
+		Dealer: North.Next(board - 1),
+	}
+	copy(d.Hands[:], Deal(4))
+	return d
+}
+
+// Checks that the hands make up a full deck and that every played card came from its player's hand.
+func (d DealRecord) Validate() error {
+	seen := make(map[Card]Seat)
+	for _, s := range Seats {
+		if len(d.Hands[s]) != 13 {
+			return fmt.Errorf("board %d: %s has %d cards, want 13", d.Board, s.Name(), len(d.Hands[s]))
+		}
+		for _, c := range d.Hands[s] {
+			if _, found := seen[c]; found {
+				return fmt.Errorf("board %d: card %s dealt twice", d.Board, c)
+			}
+			seen[c] = s
+		}
+	}
+	played := make(map[Card]bool)
+	for i, t := range d.Tricks {
+		for j, c := range t.Cards {
+			if seen[c] != t.Seat(j) || played[c] {
+				return fmt.Errorf("board %d: trick %d: %s can't play %s", d.Board, i+1, t.Seat(j).Name(), c)
+			}
+			played[c] = true
+		}
+	}
+	if len(d.Scores) != 0 && len(d.Scores) != 4 {
+		return fmt.Errorf("board %d: %d scores, want 4", d.Board, len(d.Scores))
+	}
+	return nil
+}
+
+// Writes deals as PBN records separated by blank lines.
+func WriteDeals(w io.Writer, deals []DealRecord) error {
+	for i, d := range deals {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if err := d.WritePBN(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d DealRecord) WritePBN(w io.Writer) error {
+	var sb strings.Builder
+	writeTag := func(name, value string) {
+		sb.WriteString(fmt.Sprintf("[%s \"%s\"]\n", name, value))
+	}
+	writeTag("Event", pbnString(d.Event))
+	writeTag("Site", pbnString(d.Site))
+	writeTag("Date", pbnString(d.Date))
+	writeTag("Board", strconv.Itoa(d.Board))
+	for _, s := range []Seat{West, North, East, South} {
+		writeTag(s.Name(), pbnString(d.Names[s]))
+	}
+	writeTag("Dealer", d.Dealer.String())
+	writeTag("Deal", d.dealString())
+	if len(d.Scores) > 0 {
+		var scores []string
+		for _, s := range d.Scores {
+			scores = append(scores, strconv.Itoa(s))
+		}
+		writeTag("HeartsScore", strings.Join(scores, " "))
+	}
+	if len(d.Tricks) > 0 {
+		first := d.Tricks[0].Leader
+		writeTag("Play", first.String())
+		for _, t := range d.Tricks {
+			row := []string{"-", "-", "-", "-"}
+			for i, c := range t.Cards {
+				row[(int(t.Seat(i))-int(first)+4)%4] = pbnCard(c)
+			}
+			sb.WriteString(strings.Join(row, " ") + "\n")
+		}
+		sb.WriteString("*\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func pbnString(s string) string {
+	if s == "" {
+		return "?"
+	}
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+func pbnCard(c Card) string {
+	return strings.ToUpper(c.Suit.String() + c.Value.String())
+}
+
+// Suits in the order PBN lists them within a hand.
+var pbnSuits = []Suit{Spades, Hearts, Diamonds, Clubs}
+
+func (d DealRecord) dealString() string {
+	var hands []string
+	for i := 0; i < 4; i++ {
+		cbs := d.Hands[d.Dealer.Next(i)].SplitBySuit()
+		var suits []string
+		for _, s := range pbnSuits {
+			scs := cbs[s].Copy()
+			scs.Sort()
+			var sb strings.Builder
+			for j := len(scs) - 1; j >= 0; j-- {
+				sb.WriteString(scs[j].Value.String())
+			}
+			suits = append(suits, sb.String())
+		}
+		hands = append(hands, strings.Join(suits, "."))
+	}
+	return d.Dealer.String() + ":" + strings.Join(hands, " ")
+}
+
+// Reads all deals from a PBN file.
+func ReadDealFile(path string) ([]DealRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadDeals(f)
+}
+
+var pbnTagPattern = regexp.MustCompile(`^\[(\w+)\s+"(.*)"\]$`)
+
+// Reads PBN records. Tags not used by DealRecord are ignored.
+func ReadDeals(r io.Reader) ([]DealRecord, error) {
+	var deals []DealRecord
+	var d *DealRecord
+	var inPlay bool
+	var playStart Seat
+	var playRows [][]string
+	finish := func() error {
+		if d == nil {
+			return nil
+		}
+		if len(playRows) > 0 {
+			tricks, err := decodePlay(playStart, playRows)
+			if err != nil {
+				return fmt.Errorf("board %d: %w", d.Board, err)
+			}
+			d.Tricks = tricks
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		deals = append(deals, *d)
+		d, inPlay, playRows = nil, false, nil
+		return nil
+	}
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			if err := finish(); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, ";"), strings.HasPrefix(line, "%"):
+			// Comment or directive.
+		case strings.HasPrefix(line, "["):
+			m := pbnTagPattern.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("line %d: malformed tag %s", lineNum, line)
+			}
+			if d == nil {
+				d = &DealRecord{}
+			}
+			inPlay = m[1] == "Play"
+			if err := d.setTag(m[1], strings.ReplaceAll(m[2], `\"`, `"`)); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			if inPlay {
+				playStart, _ = ParseSeat(m[2])
+			}
+		case inPlay:
+			if line == "*" {
+				inPlay = false
+				continue
+			}
+			playRows = append(playRows, strings.Fields(line))
+		default:
+			return nil, fmt.Errorf("line %d: unexpected text %s", lineNum, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return deals, nil
+}
+
+func (d *DealRecord) setTag(name, value string) error {
+	if value == "?" {
+		value = ""
+	}
+	var err error
+	switch name {
+	case "Event":
+		d.Event = value
+	case "Site":
+		d.Site = value
+	case "Date":
+		d.Date = value
+	case "Board":
+		d.Board, err = strconv.Atoi(value)
+	case "North", "East", "South", "West":
+		s, _ := ParseSeat(name)
+		d.Names[s] = value
+	case "Dealer":
+		d.Dealer, err = ParseSeat(value)
+	case "Deal":
+		err = d.parseDeal(value)
+	case "HeartsScore":
+		d.Scores = nil
+		for _, f := range strings.Fields(value) {
+			score, err := strconv.Atoi(f)
+			if err != nil {
+				return fmt.Errorf("bad HeartsScore %s", value)
+			}
+			d.Scores = append(d.Scores, score)
+		}
+	case "Play":
+		_, err = ParseSeat(value)
+	}
+	if err != nil {
+		return fmt.Errorf("bad %s tag: %w", name, err)
+	}
+	return nil
+}
+
+func (d *DealRecord) parseDeal(value string) error {
+	first, hands, found := strings.Cut(value, ":")
+	if !found {
+		return fmt.Errorf("missing first seat in %s", value)
+	}
+	seat, err := ParseSeat(first)
+	if err != nil {
+		return err
+	}
+	hs := strings.Fields(hands)
+	if len(hs) != 4 {
+		return fmt.Errorf("found %d hands, want 4", len(hs))
+	}
+	for i, h := range hs {
+		var hand Cards
+		suits := strings.Split(h, ".")
+		if len(suits) != 4 {
+			return fmt.Errorf("hand %s doesn't have 4 suits", h)
+		}
+		for j, vs := range suits {
+			for _, v := range vs {
+				value, err := parseValue(string(v))
+				if err != nil {
+					return err
+				}
+				hand = append(hand, Card{value, pbnSuits[j]})
+			}
+		}
+		hand.Sort()
+		d.Hands[seat.Next(i)] = hand
+	}
+	return nil
+}
+
+// Converts play rows (cards in seat order from first) into tricks in order of play.
+func decodePlay(first Seat, rows [][]string) ([]PlayedTrick, error) {
+	var tricks []PlayedTrick
+	leader := first
+	for i, row := range rows {
+		if len(row) > 4 {
+			return nil, fmt.Errorf("play line %d has %d cards", i+1, len(row))
+		}
+		bySeat := make(map[Seat]Card)
+		for j, cs := range row {
+			if cs == "-" || cs == "*" {
+				continue
+			}
+			c, err := parsePBNCard(cs)
+			if err != nil {
+				return nil, err
+			}
+			bySeat[first.Next(j)] = c
+		}
+		t := PlayedTrick{Leader: leader}
+		for j := 0; j < 4; j++ {
+			c, found := bySeat[leader.Next(j)]
+			if !found {
+				break
+			}
+			t.Cards = append(t.Cards, c)
+		}
+		if len(t.Cards) != len(bySeat) {
+			return nil, fmt.Errorf("play line %d is out of turn", i+1)
+		}
+		if len(t.Cards) == 0 {
+			break
+		}
+		tricks = append(tricks, t)
+		if len(t.Cards) < 4 {
+			// An unfinished trick ends the play record.
+			break
+		}
+		leader = t.Winner()
+	}
+	return tricks, nil
+}
+
+// PBN writes suit before value ("SQ"), but other notations are accepted too.
+func parsePBNCard(cs string) (Card, error) {
+	if len(cs) == 2 {
+		s, serr := parseSuit(cs[0:1])
+		v, verr := parseValue(cs[1:2])
+		if serr == nil && verr == nil {
+			return Card{v, s}, nil
+		}
+	}
+	return ParseCard(cs)
+}
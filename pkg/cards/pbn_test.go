@@ -0,0 +1,116 @@
+package cards
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testPBN = `[Event "Test"]
+[Site "?"]
+[Date "2023.10.20"]
+[Board "2"]
+[West "wes"]
+[North "nora"]
+[East "ed"]
+[South "sue"]
+[Dealer "E"]
+[Deal "N:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT98765432"]
+[HeartsScore "0 13 0 13"]
+[Play "W"]
+C2 S2 H2 D2
+CA SA HA DA
+*
+`
+
+func TestReadDeals(t *testing.T) {
+	deals, err := ReadDeals(strings.NewReader(testPBN))
+	if err != nil {
+		t.Fatalf("ReadDeals()=%v", err)
+	}
+	if len(deals) != 1 {
+		t.Fatalf("ReadDeals() found %d deals, want 1", len(deals))
+	}
+	d := deals[0]
+	if d.Board != 2 || d.Dealer != East || d.Site != "" || d.Names[North] != "nora" {
+		t.Errorf("ReadDeals() tags = %+v", d)
+	}
+	if got := d.Hands[North].CountSuit(Spades); got != 13 {
+		t.Errorf("North has %d spades, want 13", got)
+	}
+	if got := d.Hands[West].CountSuit(Clubs); got != 13 {
+		t.Errorf("West has %d clubs, want 13", got)
+	}
+	if len(d.Tricks) != 2 {
+		t.Fatalf("Found %d tricks, want 2", len(d.Tricks))
+	}
+	// West leads the 2c and keeps the lead with the Ac.
+	wantTricks := []PlayedTrick{
+		{Leader: West, Cards: Cards{C2c, C2s, C2h, C2d}},
+		{Leader: West, Cards: Cards{Cac, Cas, Cah, Cad}},
+	}
+	for i, want := range wantTricks {
+		got := d.Tricks[i]
+		if got.Leader != want.Leader || got.Cards.String() != want.Cards.String() {
+			t.Errorf("trick %d = %s %s, want %s %s", i, got.Leader, got.Cards, want.Leader, want.Cards)
+		}
+	}
+	if len(d.Scores) != 4 || d.Scores[East] != 13 {
+		t.Errorf("Scores=%v, want [0 13 0 13]", d.Scores)
+	}
+}
+
+func TestDealRoundTrip(t *testing.T) {
+	d := NewRandomDeal(7)
+	d.Event = `Club "night"`
+	d.Names = [4]string{"n", "e", "s", "w"}
+	// Play the lowest card of each hand for the first trick, starting with North.
+	d.Tricks = []PlayedTrick{{Leader: North}}
+	for _, s := range Seats {
+		d.Tricks[0].Cards = append(d.Tricks[0].Cards, d.Hands[s][0])
+	}
+	d.Scores = []int{1, 2, 3, 20}
+
+	var buf bytes.Buffer
+	if err := WriteDeals(&buf, []DealRecord{d, NewRandomDeal(8)}); err != nil {
+		t.Fatalf("WriteDeals()=%v", err)
+	}
+	deals, err := ReadDeals(&buf)
+	if err != nil {
+		t.Fatalf("ReadDeals()=%v\n%s", err, buf.String())
+	}
+	if len(deals) != 2 {
+		t.Fatalf("ReadDeals() found %d deals, want 2", len(deals))
+	}
+	got := deals[0]
+	if got.Event != d.Event || got.Board != 7 || got.Dealer != South || got.Names != d.Names {
+		t.Errorf("ReadDeals() tags = %+v, want %+v", got, d)
+	}
+	for _, s := range Seats {
+		if !got.Hands[s].Equals(d.Hands[s]) {
+			t.Errorf("%s hand = %s, want %s", s.Name(), got.Hands[s], d.Hands[s])
+		}
+	}
+	if len(got.Tricks) != 1 || got.Tricks[0].Leader != North || got.Tricks[0].Cards.String() != d.Tricks[0].Cards.String() {
+		t.Errorf("Tricks = %v, want %v", got.Tricks, d.Tricks)
+	}
+	if len(got.Scores) != 4 || got.Scores[West] != 20 {
+		t.Errorf("Scores = %v, want %v", got.Scores, d.Scores)
+	}
+}
+
+func TestReadInvalidDeals(t *testing.T) {
+	tests := []string{
+		`[Deal "N:AKQ... ... ... ..."]`,
+		`[Deal "N:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT9876543A"]`,
+		`[Deal "X:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT98765432"]`,
+		"[Deal \"N:AKQJT98765432... .AKQJT98765432.. ..AKQJT98765432. ...AKQJT98765432\"]\n[Play \"N\"]\nC2 - - -",
+		"[Board \"x\"]",
+		"Deal",
+	}
+	for _, tc := range tests {
+		if _, err := ReadDeals(strings.NewReader(tc)); err == nil {
+			t.Errorf("ReadDeals(%s) succeeded, want err", tc)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package cards
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// A seat at a four-player table, in clockwise order.
+type Seat int8
+
+const (
+	North Seat = iota
+	East
+	South
+	West
+)
+
+// Marks a player who hasn't been given a seat. It isn't one of Seats.
+const NoSeat Seat = -1
+
+var Seats = []Seat{
+	North,
+	East,
+	South,
+	West,
+}
+
+func (s Seat) String() string {
+	switch s {
+	case North:
+		return "N"
+	case East:
+		return "E"
+	case South:
+		return "S"
+	case West:
+		return "W"
+	case NoSeat:
+		return "-"
+	}
+	panic("Unknown Seat")
+}
+
+func (s Seat) Name() string {
+	switch s {
+	case North:
+		return "North"
+	case East:
+		return "East"
+	case South:
+		return "South"
+	case West:
+		return "West"
+	case NoSeat:
+		return "no seat"
+	}
+	panic("Unknown Seat")
+}
+
+// Returns the seat n places clockwise from this one.
+func (s Seat) Next(n int) Seat {
+	return Seat(((int(s)+n)%4 + 4) % 4)
+}
+
+// Accepts a seat letter or name.
+func ParseSeat(s string) (Seat, error) {
+	switch strings.ToLower(s) {
+	case "n", "north":
+		return North, nil
+	case "e", "east":
+		return East, nil
+	case "s", "south":
+		return South, nil
+	case "w", "west":
+		return West, nil
+	}
+	return NoSeat, fmt.Errorf("no such seat '%s'", s)
+}
+
+// Returns the partnership the seat is in, 1 for North and South and 2 for East and West.
+func (s Seat) Team() int {
+	switch s {
+	case North, South:
+		return 1
+	case East, West:
+		return 2
+	}
+	return 0
+}
+
+func (s Seat) ToProto() pb.GameState_Seat {
+	switch s {
+	case North:
+		return pb.GameState_North
+	case East:
+		return pb.GameState_East
+	case South:
+		return pb.GameState_South
+	case West:
+		return pb.GameState_West
+	default:
+		return pb.GameState_NoSeat
+	}
+}
+
+func SeatFromProto(seat pb.GameState_Seat) Seat {
+	switch seat {
+	case pb.GameState_North:
+		return North
+	case pb.GameState_East:
+		return East
+	case pb.GameState_South:
+		return South
+	case pb.GameState_West:
+		return West
+	default:
+		return NoSeat
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// A review of the decisions in a game, comparing each card played with the engine's evaluation.
+type GameAnalysis struct {
+	Id        string
+	Decisions []Decision       // Every play where there was a choice, in order.
+	Players   []PlayerAccuracy // By seat, with anyone who took over a seat after its first player.
+}
+
+type Grade int8
+
+const (
+	Good Grade = iota
+	Inaccuracy
+	Blunder
+)
+
+func (g Grade) String() string {
+	switch g {
+	case Good:
+		return "good"
+	case Inaccuracy:
+		return "inaccuracy"
+	case Blunder:
+		return "blunder"
+	}
+	return "unknown"
+}
+
+type Decision struct {
+	Play               int // Counting from 1.
+	Seat               cards.Seat
+	Name               string
+	Card               cards.Card
+	ExpectedPoints     float64 // Expected points taken for the rest of the hand after playing Card.
+	Best               cards.Card
+	BestExpectedPoints float64
+	Grade              Grade
+	Comment            string // e.g. "took Q♠ with K♠ when 7♠ was safe"
+}
+
+// Counting from 1.
+func (d Decision) Trick() int {
+	return (d.Play-1)/4 + 1
+}
+
+type PlayerAccuracy struct {
+	Seat         cards.Seat
+	Name         string
+	Decisions    int
+	Inaccuracies int
+	Blunders     int
+	AverageLoss  float64 // Expected points lost per decision, compared with the best play.
+	Accuracy     float64 // Percentage of decisions that weren't inaccuracies or blunders.
+}
+
+func (a GameAnalysis) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Analysis of game %s\n", a.Id))
+	seatName := func(s cards.Seat, name string) string {
+		if name == "" {
+			return s.Name()
+		}
+		return fmt.Sprintf("%s (%s)", s.Name(), name)
+	}
+	for _, d := range a.Decisions {
+		if d.Grade == Good {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("Trick %d: %s %s: %s (%.1f points worse)\n",
+			d.Trick(), seatName(d.Seat, d.Name), d.Grade, d.Comment, d.ExpectedPoints-d.BestExpectedPoints))
+	}
+	sb.WriteString(fmt.Sprintf("%-20s %8s %12s %8s %8s\n", "Player", "Accuracy", "Inaccuracies", "Blunders", "Avg loss"))
+	for _, p := range a.Players {
+		sb.WriteString(fmt.Sprintf("%-20s %7.0f%% %12d %8d %8.2f\n",
+			seatName(p.Seat, p.Name), p.Accuracy, p.Inaccuracies, p.Blunders, p.AverageLoss))
+	}
+	return sb.String()
+}
+
+func (a GameAnalysis) ToProto() *pb.GameAnalysis {
+	ap := &pb.GameAnalysis{GameId: a.Id}
+	for _, d := range a.Decisions {
+		ap.Decisions = append(ap.Decisions, &pb.GameAnalysis_Decision{
+			Play:               int32(d.Play),
+			Seat:               d.Seat.String(),
+			Name:               d.Name,
+			Card:               d.Card.String(),
+			ExpectedPoints:     d.ExpectedPoints,
+			BestCard:           d.Best.String(),
+			BestExpectedPoints: d.BestExpectedPoints,
+			Grade:              pb.GameAnalysis_Decision_Grade(d.Grade),
+			Comment:            d.Comment,
+		})
+	}
+	for _, p := range a.Players {
+		ap.Players = append(ap.Players, &pb.GameAnalysis_PlayerSummary{
+			Seat:         p.Seat.String(),
+			Name:         p.Name,
+			Decisions:    int32(p.Decisions),
+			Inaccuracies: int32(p.Inaccuracies),
+			Blunders:     int32(p.Blunders),
+			AverageLoss:  p.AverageLoss,
+			Accuracy:     p.Accuracy,
+		})
+	}
+	return ap
+}
+
+func AnalysisFromProto(ap *pb.GameAnalysis) (GameAnalysis, error) {
+	a := GameAnalysis{Id: ap.GetGameId()}
+	for _, dp := range ap.GetDecisions() {
+		seat, err := cards.ParseSeat(dp.GetSeat())
+		if err != nil {
+			return GameAnalysis{}, err
+		}
+		card, err := cards.ParseCard(dp.GetCard())
+		if err != nil {
+			return GameAnalysis{}, err
+		}
+		best, err := cards.ParseCard(dp.GetBestCard())
+		if err != nil {
+			return GameAnalysis{}, err
+		}
+		a.Decisions = append(a.Decisions, Decision{
+			Play:               int(dp.GetPlay()),
+			Seat:               seat,
+			Name:               dp.GetName(),
+			Card:               card,
+			ExpectedPoints:     dp.GetExpectedPoints(),
+			Best:               best,
+			BestExpectedPoints: dp.GetBestExpectedPoints(),
+			Grade:              Grade(dp.GetGrade()),
+			Comment:            dp.GetComment(),
+		})
+	}
+	for _, pp := range ap.GetPlayers() {
+		seat, err := cards.ParseSeat(pp.GetSeat())
+		if err != nil {
+			return GameAnalysis{}, err
+		}
+		a.Players = append(a.Players, PlayerAccuracy{
+			Seat:         seat,
+			Name:         pp.GetName(),
+			Decisions:    int(pp.GetDecisions()),
+			Inaccuracies: int(pp.GetInaccuracies()),
+			Blunders:     int(pp.GetBlunders()),
+			AverageLoss:  pp.GetAverageLoss(),
+			Accuracy:     pp.GetAccuracy(),
+		})
+	}
+	return a, nil
+}
+
+func (c *connection) AnalyzeGame(ctx context.Context, gameId string) (GameAnalysis, error) {
+	resp, err := c.client.AnalyzeGame(ctx, &pb.AnalyzeGameRequest{GameId: gameId})
+	if err != nil {
+		return GameAnalysis{}, err
+	}
+	return AnalysisFromProto(resp)
+}
@@ -16,8 +16,10 @@ import (
 	pb "github.com/mpsalisbury/cards/pkg/proto"
 	"github.com/mpsalisbury/cards/pkg/server"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 const cloudServerAddr = "api.cards.salisburyclan.com:443"
@@ -113,18 +115,56 @@ func connectToInProcessServer() (*grpc.ClientConn, pb.CardGameServiceClient, err
 type Connection interface {
 	Close()
 	Register(ctx context.Context, name string, gameCallbacks GameCallbacks) (Session, error)
+	// Registers a computer player that the server is hosting. Its session ends when ctx is done.
+	// The server only treats it as a bot if it's connected through the server's BotHost.
+	RegisterBot(ctx context.Context, name string, gameCallbacks GameCallbacks) (Session, error)
 	RegisterObserver(ctx context.Context, wg *sync.WaitGroup, name string, registryCallbacks RegistryCallbacks, gameCallbacks GameCallbacks) (Session, error)
-	CreateGame(ctx context.Context) (gameId string, err error)
+	// Resumes a session whose connection dropped, such as after restarting the client.
+	ResumeSession(ctx context.Context, resumeToken string, gameCallbacks GameCallbacks) (Session, error)
+	CreateGame(ctx context.Context, opts ...GameOption) (gameId string, err error)
 	ListGames(ctx context.Context, phase ...GamePhase) ([]GameSummary, error)
 	GetGameState(ctx context.Context, gameId string) (GameState, error)
+	// Gets the state of a game that was created with a join code.
+	GetGameStateWithCode(ctx context.Context, gameId, joinCode string) (GameState, error)
+	// Returns the record of a game that's over, even once it's deleted.
+	GetGameHistory(ctx context.Context, gameId string) (GameHistory, error)
+	// Lists the games that are over, most recently started first.
+	ListPastGames(ctx context.Context, filter PastGamesFilter) ([]PastGameSummary, error)
+	// Reviews each decision in a completed game. It may take a while.
+	AnalyzeGame(ctx context.Context, gameId string) (GameAnalysis, error)
+	// Returns a player's statistics over every completed game.
+	GetPlayerStats(ctx context.Context, name string) (PlayerStats, error)
+	// Ranks the players of completed games, best first.
+	GetLeaderboard(ctx context.Context, opts LeaderboardOptions) ([]PlayerStats, error)
 }
 type Session interface {
 	GetSessionId() string
+	// Secret that lets this session be resumed. Joined games are rejoined automatically
+	// when the connection drops, so it's only needed to resume from another connection.
+	GetResumeToken() string
 	JoinGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error
+	// Joins a game that was created with a join code.
+	JoinGameWithCode(ctx context.Context, wg *sync.WaitGroup, gameId, joinCode string) error
+	// Waits in the queue for a table of players who want the same game, then joins the game
+	// the server creates for them and returns its id.
+	JoinQueue(ctx context.Context, wg *sync.WaitGroup, opts QueueOptions) (gameId string, err error)
+	// Retakes this session's seat in a game it lost its connection to.
+	RejoinGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error
 	ObserveGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error
+	// Watches a game that was created with a join code.
+	ObserveGameWithCode(ctx context.Context, wg *sync.WaitGroup, gameId, joinCode string) error
 	ReadyToStartGame(ctx context.Context, gameId string) error
 	LeaveGame(ctx context.Context, gameId string) error
 	PlayCard(ctx context.Context, gameId string, card cards.Card) error
+	// Asks the server to fill a seat in a game that hasn't started with a bot of playerType, e.g. "expert".
+	AddBot(ctx context.Context, gameId string, playerType string) error
+	// Removes the player called name from a game this session hosts, before it starts.
+	KickPlayer(ctx context.Context, gameId string, name string) error
+	// Fills the empty seats of a game this session hosts with bots of playerType, so it
+	// starts without waiting for more people. Seats kept for players who haven't joined are filled too.
+	StartGame(ctx context.Context, gameId string, playerType string) error
+	// Moves to an empty seat in a game that hasn't started.
+	ChooseSeat(ctx context.Context, gameId string, seat cards.Seat) error
 	GetGameState(ctx context.Context, gameId string) (GameState, error)
 }
 
@@ -133,7 +173,7 @@ type GameCallbacks interface {
 	HandlePlayerLeft(s Session, name string, gameId string) error
 	HandleGameReadyToStart(s Session, gameId string) error
 	HandleGameStarted(s Session, gameId string) error
-	HandleCardPlayed(s Session, gameId string) error
+	HandleCardPlayed(s Session, gameId string, card cards.Card, playerId, playerName string) error
 	HandleYourTurn(s Session, gameId string) error
 	HandleTrickCompleted(s Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error
 	HandleGameFinished(s Session, gameId string)
@@ -153,8 +193,10 @@ func (UnimplementedGameCallbacks) HandleGameReadyToStart(s Session, gameId strin
 	return s.ReadyToStartGame(context.Background(), gameId)
 }
 func (UnimplementedGameCallbacks) HandleGameStarted(s Session, gameId string) error { return nil }
-func (UnimplementedGameCallbacks) HandleCardPlayed(s Session, gameId string) error  { return nil }
-func (UnimplementedGameCallbacks) HandleYourTurn(s Session, gameId string) error    { return nil }
+func (UnimplementedGameCallbacks) HandleCardPlayed(s Session, gameId string, card cards.Card, playerId, playerName string) error {
+	return nil
+}
+func (UnimplementedGameCallbacks) HandleYourTurn(s Session, gameId string) error { return nil }
 func (UnimplementedGameCallbacks) HandleTrickCompleted(
 	s Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error {
 	return nil
@@ -263,12 +305,32 @@ type PlayerState struct {
 	NumTricks  int
 	TrickScore int
 	HandScore  int
+	IsNext     bool // It's this player's turn.
+	IsBot      bool // The server is playing this seat.
+	Rating     float64
+	Seat       cards.Seat
+	Team       int // 1 for North and South, 2 for East and West, in a partnership game. Otherwise 0.
+}
+
+// Returns the total hand score of each team, keyed by team, in a partnership game.
+func (g GameState) TeamScores() map[int]int {
+	scores := make(map[int]int)
+	for _, p := range g.Players {
+		if p.Team != 0 {
+			scores[p.Team] += p.HandScore
+		}
+	}
+	return scores
 }
 
 func (g GameState) String() string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("Game Phase: %s\n", g.Phase))
-	if g.Phase != Preparing {
+	if g.Phase == Preparing {
+		for _, p := range g.Players {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", p.Seat.Name(), p.Name))
+		}
+	} else {
 		for _, p := range g.Players {
 			sb.WriteString(p.String(g.Phase == Completed))
 		}
@@ -278,13 +340,25 @@ func (g GameState) String() string {
 		if len(g.LegalPlays) > 0 {
 			sb.WriteString(fmt.Sprintf("Legal Plays: %s", g.LegalPlays))
 		}
+		if teams := g.TeamScores(); g.Phase == Completed && len(teams) > 0 {
+			sb.WriteString(fmt.Sprintf("Team Scores: %d (North and South), %d (East and West)\n", teams[1], teams[2]))
+		}
 	}
 	return sb.String()
 }
 
 func (p PlayerState) String(isCompleted bool) string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("Name: %s\n", p.Name))
+	if p.IsBot {
+		sb.WriteString(fmt.Sprintf("Name: %s (bot)\n", p.Name))
+	} else {
+		sb.WriteString(fmt.Sprintf("Name: %s\n", p.Name))
+	}
+	if p.Team != 0 {
+		sb.WriteString(fmt.Sprintf("Seat: %s, team %d\n", p.Seat.Name(), p.Team))
+	} else if p.Seat != cards.NoSeat {
+		sb.WriteString(fmt.Sprintf("Seat: %s\n", p.Seat.Name()))
+	}
 	if len(p.Cards) > 0 {
 		sb.WriteString(fmt.Sprintf("Cards: %s\n", p.Cards.HandString()))
 	} else if p.NumCards > 0 {
@@ -315,10 +389,9 @@ func NewConnection(conn *grpc.ClientConn, client pb.CardGameServiceClient, verbo
 }
 
 type connection struct {
-	conn              *grpc.ClientConn
-	client            pb.CardGameServiceClient
-	registryCallbacks RegistryCallbacks
-	verbose           bool
+	conn    *grpc.ClientConn
+	client  pb.CardGameServiceClient
+	verbose bool
 }
 
 func (c *connection) Close() {
@@ -335,6 +408,14 @@ func (c *connection) Register(ctx context.Context, name string, gameCallbacks Ga
 	registryCallbacks := &UnimplementedRegistryCallbacks{}
 	return c.RegisterObserver(ctx, wg, name, registryCallbacks, gameCallbacks)
 }
+func (c *connection) RegisterBot(ctx context.Context, name string, gameCallbacks GameCallbacks) (Session, error) {
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	req := &pb.RegisterRequest{
+		Name: name,
+	}
+	return c.register(ctx, wg, req, &UnimplementedRegistryCallbacks{}, gameCallbacks)
+}
 func (c *connection) RegisterObserver(ctx context.Context, wg *sync.WaitGroup, name string,
 	registryCallbacks RegistryCallbacks, gameCallbacks GameCallbacks) (Session, error) {
 	if name == "" {
@@ -343,45 +424,158 @@ func (c *connection) RegisterObserver(ctx context.Context, wg *sync.WaitGroup, n
 	req := &pb.RegisterRequest{
 		Name: name,
 	}
+	return c.register(ctx, wg, req, registryCallbacks, gameCallbacks)
+}
+func (c *connection) register(ctx context.Context, wg *sync.WaitGroup, req *pb.RegisterRequest,
+	registryCallbacks RegistryCallbacks, gameCallbacks GameCallbacks) (Session, error) {
 	registryActivityStream, err := c.client.Register(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	c.registryCallbacks = registryCallbacks
-	sessionIdChan := make(chan string)
+	return c.startSession(ctx, wg, registryActivityStream, registryCallbacks, gameCallbacks)
+}
+func (c *connection) ResumeSession(ctx context.Context, resumeToken string, gameCallbacks GameCallbacks) (Session, error) {
+	req := &pb.ResumeSessionRequest{
+		ResumeToken: resumeToken,
+	}
+	registryActivityStream, err := c.client.ResumeSession(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	wg := new(sync.WaitGroup)
 	wg.Add(1)
-	go c.processRegistryActivity(wg, sessionIdChan, registryActivityStream)
+	return c.startSession(ctx, wg, registryActivityStream, &UnimplementedRegistryCallbacks{}, gameCallbacks)
+}
+
+// Processes registryActivityStream and returns the session it's for.
+func (c *connection) startSession(ctx context.Context, wg *sync.WaitGroup, registryActivityStream pb.CardGameService_RegisterClient,
+	registryCallbacks RegistryCallbacks, gameCallbacks GameCallbacks) (Session, error) {
+	sessionCreatedChan := make(chan *pb.RegistryActivity_SessionCreated)
+	wg.Add(1)
+	go c.processRegistryActivity(ctx, wg, sessionCreatedChan, registryActivityStream, registryCallbacks)
 	// TODO: add timeout
-	sessionId := <-sessionIdChan
-	session := newSession(c.client, sessionId, gameCallbacks, c.verbose)
+	sc, ok := <-sessionCreatedChan
+	if !ok {
+		return nil, fmt.Errorf("server didn't create a session")
+	}
+	session := newSession(c.client, sc.GetSessionId(), sc.GetResumeToken(), gameCallbacks, c.verbose)
 	registryCallbacks.InstallSession(session)
 	return session, nil
 }
 
-func newSession(client pb.CardGameServiceClient, sessionId string, gameCallbacks GameCallbacks, verbose bool) Session {
+func newSession(client pb.CardGameServiceClient, sessionId, resumeToken string, gameCallbacks GameCallbacks, verbose bool) Session {
 	return &session{
 		client:        client,
 		sessionId:     sessionId,
+		resumeToken:   resumeToken,
 		gameCallbacks: gameCallbacks,
 		verbose:       verbose,
+		joined:        make(map[string]*joinedGame),
 	}
 }
 
 type session struct {
 	client        pb.CardGameServiceClient
 	sessionId     string
+	resumeToken   string
 	gameCallbacks GameCallbacks
 	verbose       bool
+	mu            sync.Mutex
+	joined        map[string]*joinedGame // Keyed by gameId.
 }
 
 type GameSummary struct {
-	Id    string
-	Phase GamePhase
-	Names []string
+	Id      string
+	Phase   GamePhase
+	Names   []string
+	Ratings []float64 // In the order of Names.
+}
+
+// Returns the average rating of the players at the table, or 0 if there are none.
+func (gs GameSummary) AverageRating() float64 {
+	if len(gs.Ratings) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, r := range gs.Ratings {
+		total += r
+	}
+	return total / float64(len(gs.Ratings))
+}
+
+// GameOption customizes a game made by CreateGame.
+type GameOption func(*pb.CreateGameRequest)
+
+// Deals the given hands to players by seat, from North, instead of shuffling.
+func WithDeal(hands []cards.Cards) GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.Hands = cards.ToProtos(hands)
+	}
 }
 
-func (c *connection) CreateGame(ctx context.Context) (gameId string, err error) {
+// Plays these cards, in order, as soon as the game starts, to continue a recorded game from
+// partway through. Each is played by whoever holds it, so it needs WithDeal.
+func WithPlays(plays cards.Cards) GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.Plays = plays.Strings()
+	}
+}
+
+// Hides the game from ListGames. Players join it by its id.
+func Private() GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.Visibility = pb.CreateGameRequest_Private
+	}
+}
+
+// Makes players give code to join the game.
+func WithJoinCode(code string) GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.JoinCode = code
+	}
+}
+
+// Keeps seats for the players with these names until they join.
+func WithReservedSeats(names ...string) GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.ReservedNames = append(req.ReservedNames, names...)
+	}
+}
+
+// Makes s the game's host, who can kick players and add bots before it starts, and start it early.
+func HostedBy(s Session) GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.SessionId = s.GetSessionId()
+	}
+}
+
+// Makes players sitting across from each other partners, North and South against East and West.
+func WithPartnerships() GameOption {
+	return func(req *pb.CreateGameRequest) {
+		req.Partnerships = true
+	}
+}
+
+// Reads the deal for the given board number from a PBN file.
+// If board is 0, the first deal in the file is used.
+func DealFromFile(path string, board int) (GameOption, error) {
+	deals, err := cards.ReadDealFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deals {
+		if board == 0 || d.Board == board {
+			return WithDeal(d.Hands[:]), nil
+		}
+	}
+	return nil, fmt.Errorf("no board %d in %s", board, path)
+}
+
+func (c *connection) CreateGame(ctx context.Context, opts ...GameOption) (gameId string, err error) {
 	req := &pb.CreateGameRequest{}
+	for _, opt := range opts {
+		opt(req)
+	}
 	resp, err := c.client.CreateGame(ctx, req)
 	if err != nil {
 		return "", err
@@ -404,9 +598,10 @@ func (c *connection) ListGames(ctx context.Context, phase ...GamePhase) ([]GameS
 	for _, g := range resp.GetGames() {
 		games = append(games,
 			GameSummary{
-				Id:    g.GetId(),
-				Phase: protoToPhase(g.GetPhase()),
-				Names: g.GetPlayerNames(),
+				Id:      g.GetId(),
+				Phase:   protoToPhase(g.GetPhase()),
+				Names:   g.GetPlayerNames(),
+				Ratings: g.GetPlayerRatings(),
 			})
 	}
 	return games, nil
@@ -414,33 +609,109 @@ func (c *connection) ListGames(ctx context.Context, phase ...GamePhase) ([]GameS
 func (s *session) GetSessionId() string {
 	return s.sessionId
 }
+func (s *session) GetResumeToken() string {
+	return s.resumeToken
+}
 func (s *session) JoinGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error {
+	return s.JoinGameWithCode(ctx, wg, gameId, "")
+}
+func (s *session) JoinGameWithCode(ctx context.Context, wg *sync.WaitGroup, gameId, joinCode string) error {
 	req := &pb.JoinGameRequest{
 		SessionId: s.sessionId,
 		GameId:    gameId,
+		JoinCode:  joinCode,
 	}
 	gameActivityStream, err := s.client.JoinGame(ctx, req)
 	if err != nil {
 		return err
 	}
 	wg.Add(1)
-	go s.processGameActivity(wg, gameActivityStream)
+	go s.processGameActivity(ctx, wg, gameId, gameActivityStream, s.joinGame(gameId))
 	return nil
 }
+func (s *session) RejoinGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error {
+	// Without knowing what was received before, the whole game so far is sent again.
+	gameActivityStream, err := s.rejoin(ctx, gameId, 0)
+	if err != nil {
+		return err
+	}
+	wg.Add(1)
+	go s.processGameActivity(ctx, wg, gameId, gameActivityStream, s.joinGame(gameId))
+	return nil
+}
+func (s *session) rejoin(ctx context.Context, gameId string, activitiesReceived int) (pb.CardGameService_ObserveGameClient, error) {
+	req := &pb.RejoinGameRequest{
+		ResumeToken:        s.resumeToken,
+		GameId:             gameId,
+		ActivitiesReceived: int32(activitiesReceived),
+	}
+	return s.client.RejoinGame(ctx, req)
+}
 func (s *session) ObserveGame(ctx context.Context, wg *sync.WaitGroup, gameId string) error {
+	return s.ObserveGameWithCode(ctx, wg, gameId, "")
+}
+func (s *session) ObserveGameWithCode(ctx context.Context, wg *sync.WaitGroup, gameId, joinCode string) error {
 	req := &pb.ObserveGameRequest{
 		SessionId: s.sessionId,
 		GameId:    gameId,
+		JoinCode:  joinCode,
 	}
 	gameActivityStream, err := s.client.ObserveGame(ctx, req)
 	if err != nil {
 		return err
 	}
 	wg.Add(1)
-	go s.processGameActivity(wg, gameActivityStream)
+	go s.processGameActivity(ctx, wg, gameId, gameActivityStream, nil)
 	return nil
 }
 
+// How long to keep trying to reattach a stream whose connection dropped, and how often.
+// Servers keep a player's place for a minute by default.
+const (
+	reconnectTimeout  = 30 * time.Second
+	reconnectInterval = time.Second
+)
+
+// Reattaches a stream of type S when its connection drops.
+type reconnector[S any] struct {
+	ctx       context.Context
+	reconnect func() (S, error)
+	giveUpAt  time.Time // When to stop trying. Zero while connected.
+}
+
+// Returns a new stream to replace one that failed with err, or an error if it can't be replaced.
+func (r *reconnector[S]) replace(err error) (S, error) {
+	var stream S
+	if r == nil || r.ctx.Err() != nil {
+		return stream, err
+	}
+	if r.giveUpAt.IsZero() {
+		if !isConnClosedErr(err) && status.Code(err) != codes.Unavailable {
+			return stream, err
+		}
+		r.giveUpAt = time.Now().Add(reconnectTimeout)
+	}
+	// Retry until the server notices the connection dropped and lets us back in.
+	for time.Now().Before(r.giveUpAt) {
+		select {
+		case <-time.After(reconnectInterval):
+		case <-r.ctx.Done():
+			return stream, err
+		}
+		if stream, err = r.reconnect(); err == nil {
+			return stream, nil
+		}
+	}
+	return stream, err
+}
+
+// Records that the stream is working again.
+func (r *reconnector[S]) connected() {
+	if r != nil {
+		r.giveUpAt = time.Time{}
+	}
+}
+
 // possible conn closed errors.
 const possibleConnResetMsg = "connection reset by peer"
 const possibleEOFMsg = "error reading from server: EOF"
@@ -452,22 +723,75 @@ func isConnClosedErr(err error) bool {
 	return errContainsConnResetMsg || errContainsEOFMsg || err == io.EOF
 }
 
-// Handles both JoinGame and ObserveGame streams.
-func (s *session) processGameActivity(wg *sync.WaitGroup, gameActivityStream pb.CardGameService_ObserveGameClient) {
+// A game this session is playing in.
+type joinedGame struct {
+	left bool // The player has left, so the server ending its stream isn't a dropped connection.
+}
+
+// Records that this session is playing in gameId.
+func (s *session) joinGame(gameId string) *joinedGame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j := &joinedGame{}
+	s.joined[gameId] = j
+	return j
+}
+
+func (s *session) setLeft(gameId string, left bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if j, ok := s.joined[gameId]; ok {
+		j.left = left
+	}
+}
+
+func (s *session) hasLeft(j *joinedGame) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return j.left
+}
+
+// Handles JoinGame, RejoinGame and ObserveGame streams. A player's stream, for which
+// joined is set, is rejoined if the connection drops, picking up where it left off.
+func (s *session) processGameActivity(ctx context.Context, wg *sync.WaitGroup, gameId string,
+	gameActivityStream pb.CardGameService_ObserveGameClient, joined *joinedGame) {
 	defer wg.Done()
+	received := 0
+	var rejoiner *reconnector[pb.CardGameService_ObserveGameClient]
+	if joined != nil {
+		rejoiner = &reconnector[pb.CardGameService_ObserveGameClient]{
+			ctx: ctx,
+			reconnect: func() (pb.CardGameService_ObserveGameClient, error) {
+				log.Printf("Lost connection to game %s, rejoining", gameId)
+				return s.rejoin(ctx, gameId, received)
+			},
+		}
+	}
 	for {
 		activity, err := gameActivityStream.Recv()
+		if err != nil && joined != nil && s.hasLeft(joined) {
+			// The server ends the stream of a player who leaves.
+			return
+		}
+		if err != nil {
+			if stream, rerr := rejoiner.replace(err); rerr == nil {
+				gameActivityStream = stream
+				continue
+			}
+		}
+		rejoiner.connected()
+		received++
 		if err != nil && isConnClosedErr(err) {
 			s.gameCallbacks.HandleConnectionError(s, fmt.Errorf("Connection to server closed"))
 			return
 		}
 		if err != nil {
-			log.Fatalf("ListenForGameActivity(_) = _, %v", err)
+			s.gameCallbacks.HandleConnectionError(s, err)
+			return
 		}
 		if s.verbose {
 			log.Println(activity)
 		}
-		gameId := activity.GetGameId()
 		switch a := activity.Type.(type) {
 		case *pb.GameActivity_PlayerJoined_:
 			pj := a.PlayerJoined
@@ -479,6 +803,12 @@ func (s *session) processGameActivity(wg *sync.WaitGroup, gameActivityStream pb.
 			err = s.gameCallbacks.HandleGameReadyToStart(s, gameId)
 		case *pb.GameActivity_GameStarted_:
 			err = s.gameCallbacks.HandleGameStarted(s, gameId)
+		case *pb.GameActivity_CardPlayed_:
+			cp := a.CardPlayed
+			card, err1 := cards.ParseCard(cp.GetCard())
+			if err1 == nil {
+				err = s.gameCallbacks.HandleCardPlayed(s, gameId, card, cp.GetPlayerId(), cp.GetPlayerName())
+			}
 		case *pb.GameActivity_YourTurn_:
 			err = s.gameCallbacks.HandleYourTurn(s, gameId)
 		case *pb.GameActivity_TrickCompleted_:
@@ -496,6 +826,12 @@ func (s *session) processGameActivity(wg *sync.WaitGroup, gameActivityStream pb.
 			return
 		}
 		if err != nil {
+			// The activity will be sent again if the connection dropped while handling it.
+			received--
+			if stream, rerr := rejoiner.replace(err); rerr == nil {
+				gameActivityStream = stream
+				continue
+			}
 			log.Printf("Error handling activity: %v\n", err)
 			return
 		}
@@ -507,7 +843,13 @@ func (s *session) ReadyToStartGame(ctx context.Context, gameId string) error {
 }
 
 func (s *session) LeaveGame(ctx context.Context, gameId string) error {
-	return s.performGameAction(ctx, gameId, &pb.GameActionRequest_LeaveGame{})
+	// Marked first, since the server may end the game's stream before this returns.
+	s.setLeft(gameId, true)
+	err := s.performGameAction(ctx, gameId, &pb.GameActionRequest_LeaveGame{})
+	if err != nil {
+		s.setLeft(gameId, false)
+	}
+	return err
 }
 
 func (s *session) PlayCard(ctx context.Context, gameId string, card cards.Card) error {
@@ -521,6 +863,50 @@ func (s *session) PlayCard(ctx context.Context, gameId string, card cards.Card)
 	)
 }
 
+func (s *session) AddBot(ctx context.Context, gameId string, playerType string) error {
+	return s.performGameAction(ctx,
+		gameId,
+		&pb.GameActionRequest_AddBot{
+			AddBot: &pb.AddBotAction{
+				PlayerType: playerType,
+			},
+		},
+	)
+}
+
+func (s *session) KickPlayer(ctx context.Context, gameId string, name string) error {
+	return s.performGameAction(ctx,
+		gameId,
+		&pb.GameActionRequest_KickPlayer{
+			KickPlayer: &pb.KickPlayerAction{
+				Name: name,
+			},
+		},
+	)
+}
+
+func (s *session) StartGame(ctx context.Context, gameId string, playerType string) error {
+	return s.performGameAction(ctx,
+		gameId,
+		&pb.GameActionRequest_StartGame{
+			StartGame: &pb.StartGameAction{
+				PlayerType: playerType,
+			},
+		},
+	)
+}
+
+func (s *session) ChooseSeat(ctx context.Context, gameId string, seat cards.Seat) error {
+	return s.performGameAction(ctx,
+		gameId,
+		&pb.GameActionRequest_ChooseSeat{
+			ChooseSeat: &pb.ChooseSeatAction{
+				Seat: seat.ToProto(),
+			},
+		},
+	)
+}
+
 func (s *session) performGameAction(ctx context.Context, gameId string, requestType pb.GameActionRequest_Type) error {
 	req := &pb.GameActionRequest{
 		SessionId: s.sessionId,
@@ -538,8 +924,12 @@ func (s *session) performGameAction(ctx context.Context, gameId string, requestT
 }
 
 func (c *connection) GetGameState(ctx context.Context, gameId string) (GameState, error) {
+	return c.GetGameStateWithCode(ctx, gameId, "")
+}
+func (c *connection) GetGameStateWithCode(ctx context.Context, gameId, joinCode string) (GameState, error) {
 	req := &pb.GameStateRequest{
-		GameId: gameId,
+		GameId:   gameId,
+		JoinCode: joinCode,
 	}
 	return getGameState(ctx, c.client, req)
 }
@@ -613,36 +1003,70 @@ func toPlayerState(p *pb.GameState_Player) (PlayerState, error) {
 		NumTricks:  int(p.GetNumTricks()),
 		TrickScore: int(p.GetTrickScore()),
 		HandScore:  int(p.GetHandScore()),
+		IsNext:     p.GetIsNextPlayer(),
+		IsBot:      p.GetIsBot(),
+		Rating:     p.GetRating(),
+		Seat:       cards.SeatFromProto(p.GetSeat()),
+		Team:       int(p.GetTeam()),
 	}, nil
 }
 
-func (c *connection) processRegistryActivity(wg *sync.WaitGroup, sessionIdChan chan string, registryActivityStream pb.CardGameService_RegisterClient) {
+// Sends the first SessionCreated activity to sessionCreatedChan, or closes it if the stream
+// fails first. Resumes the session if the connection drops.
+func (c *connection) processRegistryActivity(ctx context.Context, wg *sync.WaitGroup, sessionCreatedChan chan *pb.RegistryActivity_SessionCreated,
+	registryActivityStream pb.CardGameService_RegisterClient, registryCallbacks RegistryCallbacks) {
 	defer wg.Done()
+	defer func() {
+		if sessionCreatedChan != nil {
+			close(sessionCreatedChan)
+		}
+	}()
+	var resumer *reconnector[pb.CardGameService_RegisterClient]
 	for {
 		activity, err := registryActivityStream.Recv()
+		if err != nil {
+			if stream, rerr := resumer.replace(err); rerr == nil {
+				registryActivityStream = stream
+				continue
+			}
+		}
+		resumer.connected()
 		if err != nil && isConnClosedErr(err) {
-			c.registryCallbacks.HandleConnectionError(c, fmt.Errorf("Connection to server closed"))
+			registryCallbacks.HandleConnectionError(c, fmt.Errorf("Connection to server closed"))
 			return
 		}
 		if err != nil {
-			log.Fatalf("ListenForRegistryActivity(_) = _, %v", err)
+			registryCallbacks.HandleConnectionError(c, err)
+			return
 		}
 		if c.verbose {
 			log.Println(activity)
 		}
 		switch a := activity.Type.(type) {
 		case *pb.RegistryActivity_SessionCreated_:
-			sessionId := a.SessionCreated.GetSessionId()
-			sessionIdChan <- sessionId
+			if sessionCreatedChan == nil {
+				// Sent again when the session is resumed.
+				break
+			}
+			sessionCreatedChan <- a.SessionCreated
+			sessionCreatedChan = nil
+			req := &pb.ResumeSessionRequest{ResumeToken: a.SessionCreated.GetResumeToken()}
+			resumer = &reconnector[pb.CardGameService_RegisterClient]{
+				ctx: ctx,
+				reconnect: func() (pb.CardGameService_RegisterClient, error) {
+					log.Printf("Lost connection to server, resuming session")
+					return c.client.ResumeSession(ctx, req)
+				},
+			}
 		case *pb.RegistryActivity_GameCreated_:
 			gameId := a.GameCreated.GetGameId()
-			err = c.registryCallbacks.HandleGameCreated(c, gameId)
+			err = registryCallbacks.HandleGameCreated(c, gameId)
 		case *pb.RegistryActivity_GameDeleted_:
 			gameId := a.GameDeleted.GetGameId()
-			err = c.registryCallbacks.HandleGameDeleted(c, gameId)
+			err = registryCallbacks.HandleGameDeleted(c, gameId)
 		case *pb.RegistryActivity_FullGamesList_:
 			gameIds := a.FullGamesList.GetGameIds()
-			err = c.registryCallbacks.HandleFullGamesList(c, gameIds)
+			err = registryCallbacks.HandleFullGamesList(c, gameIds)
 		}
 		if err != nil {
 			log.Printf("Error handling activity: %v\n", err)
@@ -652,7 +1076,12 @@ func (c *connection) processRegistryActivity(wg *sync.WaitGroup, sessionIdChan c
 }
 
 func newInProcessServer() pb.CardGameServiceClient {
-	return &inProcessServer{server: server.NewCardGameService()}
+	return &inProcessServer{server: server.NewCardGameService(server.Options{})}
+}
+
+// Connects to a service running in this process, such as a server starting its own bots.
+func ConnectToService(service pb.CardGameServiceServer, verbose bool) Connection {
+	return NewConnection(nil, &inProcessServer{server: service}, verbose)
 }
 
 type inProcessServer struct {
@@ -663,8 +1092,13 @@ func (s inProcessServer) Ping(ctx context.Context, in *pb.PingRequest, opts ...g
 	return s.server.Ping(ctx, in)
 }
 func (s inProcessServer) Register(ctx context.Context, in *pb.RegisterRequest, opts ...grpc.CallOption) (pb.CardGameService_RegisterClient, error) {
-	client, server := makeRegisterLocalConnectors()
-	go s.server.Register(in, server)
+	client, server := makeRegisterLocalConnectors(ctx)
+	go func() { client.finish(s.server.Register(in, server)) }()
+	return client, nil
+}
+func (s inProcessServer) ResumeSession(ctx context.Context, in *pb.ResumeSessionRequest, opts ...grpc.CallOption) (pb.CardGameService_ResumeSessionClient, error) {
+	client, server := makeRegisterLocalConnectors(ctx)
+	go func() { client.finish(s.server.ResumeSession(in, server)) }()
 	return client, nil
 }
 func (s inProcessServer) CreateGame(ctx context.Context, in *pb.CreateGameRequest, opts ...grpc.CallOption) (*pb.CreateGameResponse, error) {
@@ -674,13 +1108,18 @@ func (s inProcessServer) ListGames(ctx context.Context, in *pb.ListGamesRequest,
 	return s.server.ListGames(ctx, in)
 }
 func (s inProcessServer) JoinGame(ctx context.Context, in *pb.JoinGameRequest, opts ...grpc.CallOption) (pb.CardGameService_JoinGameClient, error) {
-	client, server := makeObserveGameLocalConnectors()
-	go s.server.JoinGame(in, server)
+	client, server := makeObserveGameLocalConnectors(ctx)
+	go func() { client.finish(s.server.JoinGame(in, server)) }()
+	return client, nil
+}
+func (s inProcessServer) RejoinGame(ctx context.Context, in *pb.RejoinGameRequest, opts ...grpc.CallOption) (pb.CardGameService_RejoinGameClient, error) {
+	client, server := makeObserveGameLocalConnectors(ctx)
+	go func() { client.finish(s.server.RejoinGame(in, server)) }()
 	return client, nil
 }
 func (s inProcessServer) ObserveGame(ctx context.Context, in *pb.ObserveGameRequest, opts ...grpc.CallOption) (pb.CardGameService_ObserveGameClient, error) {
-	client, server := makeObserveGameLocalConnectors()
-	go s.server.ObserveGame(in, server)
+	client, server := makeObserveGameLocalConnectors(ctx)
+	go func() { client.finish(s.server.ObserveGame(in, server)) }()
 	return client, nil
 }
 func (s inProcessServer) GameAction(ctx context.Context, in *pb.GameActionRequest, opts ...grpc.CallOption) (*pb.Status, error) {
@@ -689,11 +1128,32 @@ func (s inProcessServer) GameAction(ctx context.Context, in *pb.GameActionReques
 func (s inProcessServer) GetGameState(ctx context.Context, in *pb.GameStateRequest, opts ...grpc.CallOption) (*pb.GameState, error) {
 	return s.server.GetGameState(ctx, in)
 }
+func (s inProcessServer) GetGameHistory(ctx context.Context, in *pb.GameHistoryRequest, opts ...grpc.CallOption) (*pb.GameHistory, error) {
+	return s.server.GetGameHistory(ctx, in)
+}
+func (s inProcessServer) ListPastGames(ctx context.Context, in *pb.ListPastGamesRequest, opts ...grpc.CallOption) (*pb.ListPastGamesResponse, error) {
+	return s.server.ListPastGames(ctx, in)
+}
+func (s inProcessServer) AnalyzeGame(ctx context.Context, in *pb.AnalyzeGameRequest, opts ...grpc.CallOption) (*pb.GameAnalysis, error) {
+	return s.server.AnalyzeGame(ctx, in)
+}
+func (s inProcessServer) GetPlayerStats(ctx context.Context, in *pb.PlayerStatsRequest, opts ...grpc.CallOption) (*pb.PlayerStats, error) {
+	return s.server.GetPlayerStats(ctx, in)
+}
+func (s inProcessServer) JoinQueue(ctx context.Context, in *pb.JoinQueueRequest, opts ...grpc.CallOption) (pb.CardGameService_JoinQueueClient, error) {
+	client, server := makeQueueLocalConnectors(ctx)
+	go func() { client.finish(s.server.JoinQueue(in, server)) }()
+	return client, nil
+}
+func (s inProcessServer) GetLeaderboard(ctx context.Context, in *pb.LeaderboardRequest, opts ...grpc.CallOption) (*pb.Leaderboard, error) {
+	return s.server.GetLeaderboard(ctx, in)
+}
 
-func makeRegisterLocalConnectors() (pb.CardGameService_RegisterClient, pb.CardGameService_RegisterServer) {
+// Works for both Register and ResumeSession. The streams end when ctx is done.
+func makeRegisterLocalConnectors(ctx context.Context) (*localRegistryActivityClient, pb.CardGameService_RegisterServer) {
 	ch := make(chan *pb.RegistryActivity)
-	client := &localRegistryActivityClient{ch: ch}
-	server := &localRegistryActivityServer{ch: ch, svrctx: context.Background()}
+	client := &localRegistryActivityClient{ch: ch, ctx: ctx, done: make(chan struct{})}
+	server := &localRegistryActivityServer{ch: ch, svrctx: ctx}
 	return client, server
 }
 
@@ -704,8 +1164,12 @@ type localRegistryActivityServer struct {
 }
 
 func (s localRegistryActivityServer) Send(ra *pb.RegistryActivity) error {
-	s.ch <- ra
-	return nil
+	select {
+	case s.ch <- ra:
+		return nil
+	case <-s.svrctx.Done():
+		return s.svrctx.Err()
+	}
 }
 
 func (s localRegistryActivityServer) Context() context.Context {
@@ -714,19 +1178,37 @@ func (s localRegistryActivityServer) Context() context.Context {
 
 type localRegistryActivityClient struct {
 	grpc.ClientStream
-	ch chan *pb.RegistryActivity
+	ch   chan *pb.RegistryActivity
+	ctx  context.Context
+	done chan struct{} // Closed when the server has finished the stream.
+	err  error         // Why the server finished the stream, set before done is closed.
+}
+
+// Records that the server has finished the stream, with err if it failed.
+func (c *localRegistryActivityClient) finish(err error) {
+	if c.ctx.Err() != nil || err == nil {
+		err = io.EOF
+	}
+	c.err = err
+	close(c.done)
 }
 
-func (c localRegistryActivityClient) Recv() (*pb.RegistryActivity, error) {
-	ra := <-c.ch
-	return ra, nil
+func (c *localRegistryActivityClient) Recv() (*pb.RegistryActivity, error) {
+	select {
+	case ra := <-c.ch:
+		return ra, nil
+	case <-c.ctx.Done():
+		return nil, io.EOF
+	case <-c.done:
+		return nil, c.err
+	}
 }
 
-// Works for both JoinGame and ObserveGame servers.
-func makeObserveGameLocalConnectors() (pb.CardGameService_ObserveGameClient, pb.CardGameService_ObserveGameServer) {
+// Works for both JoinGame and ObserveGame servers. The server stream ends when ctx is done.
+func makeObserveGameLocalConnectors(ctx context.Context) (*localGameActivityClient, pb.CardGameService_ObserveGameServer) {
 	ch := make(chan *pb.GameActivity)
-	client := &localGameActivityClient{ch: ch}
-	server := &localGameActivityServer{ch: ch, svrctx: context.Background()}
+	client := &localGameActivityClient{ch: ch, done: make(chan struct{})}
+	server := &localGameActivityServer{ch: ch, svrctx: ctx}
 	return client, server
 }
 
@@ -741,16 +1223,35 @@ func (s localGameActivityServer) Context() context.Context {
 }
 
 func (s localGameActivityServer) Send(ga *pb.GameActivity) error {
-	s.ch <- ga
-	return nil
+	select {
+	case s.ch <- ga:
+		return nil
+	case <-s.svrctx.Done():
+		return s.svrctx.Err()
+	}
 }
 
 type localGameActivityClient struct {
 	grpc.ClientStream
-	ch chan *pb.GameActivity
+	ch   chan *pb.GameActivity
+	done chan struct{} // Closed when the server has finished the stream.
+	err  error         // Why the server finished the stream, set before done is closed.
 }
 
-func (c localGameActivityClient) Recv() (*pb.GameActivity, error) {
-	ga := <-c.ch
-	return ga, nil
+// Records that the server has finished the stream, with err if it failed.
+func (c *localGameActivityClient) finish(err error) {
+	if err == nil {
+		err = io.EOF
+	}
+	c.err = err
+	close(c.done)
+}
+
+func (c *localGameActivityClient) Recv() (*pb.GameActivity, error) {
+	select {
+	case ga := <-c.ch:
+		return ga, nil
+	case <-c.done:
+		return nil, c.err
+	}
 }
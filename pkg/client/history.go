@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// The record of a game that's over.
+type GameHistory struct {
+	Id         string
+	Phase      GamePhase // Completed or Aborted
+	Players    []HistoryPlayer
+	Hands      []cards.Cards // Dealt to Players, in the same order.
+	Plays      []Play        // Every card played, in order. Trick i is Plays[4*i : 4*i+4].
+	Tricks     []TrickResult // Every completed trick, in order.
+	HandScores []int         // In the order of Players. Empty if the game was aborted.
+	Start      time.Time
+	End        time.Time
+}
+
+type HistoryPlayer struct {
+	Id    string
+	Name  string
+	IsBot bool
+}
+
+type Play struct {
+	Time       time.Time
+	Card       cards.Card
+	PlayerId   string
+	PlayerName string // Of whoever was playing the seat at the time.
+}
+
+type TrickResult struct {
+	Time        time.Time
+	Trick       cards.Cards
+	WinningCard cards.Card
+	WinnerId    string
+	WinnerName  string
+}
+
+func (h GameHistory) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Game %s %s, played %s\n", h.Id, h.Phase, h.Start.Format("2006-01-02 15:04")))
+	sb.WriteString("Deal:\n")
+	for i, p := range h.Players {
+		name := p.Name
+		if p.IsBot {
+			name += " (bot)"
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s\n", name, h.Hands[i].HandString()))
+	}
+	for i, t := range h.Tricks {
+		var plays []string
+		for _, p := range h.Plays[4*i : 4*i+4] {
+			plays = append(plays, fmt.Sprintf("%s %s", p.PlayerName, p.Card))
+		}
+		sb.WriteString(fmt.Sprintf("Trick %d: %s - won by %s\n", i+1, strings.Join(plays, ", "), t.WinnerName))
+	}
+	if len(h.HandScores) > 0 {
+		sb.WriteString("Hand Scores:\n")
+		for i, p := range h.Players {
+			sb.WriteString(fmt.Sprintf("  %s: %d\n", p.Name, h.HandScores[i]))
+		}
+	} else {
+		sb.WriteString(fmt.Sprintf("Aborted after %d cards\n", len(h.Plays)))
+	}
+	return sb.String()
+}
+
+// Returns the game as a deal record, such as for writing to a PBN file. The players
+// sit North, East, South and West in turn order. An aborted game's last trick may be unfinished.
+func (h GameHistory) DealRecord() cards.DealRecord {
+	d := cards.DealRecord{
+		Event:  "Game " + h.Id,
+		Date:   h.Start.Format("2006.01.02"),
+		Board:  1,
+		Dealer: cards.North,
+	}
+	seats := make(map[string]cards.Seat)
+	for i, p := range h.Players {
+		seats[p.Id] = cards.Seat(i)
+		d.Names[i] = p.Name
+		d.Hands[i] = h.Hands[i].Copy()
+	}
+	for i, p := range h.Plays {
+		if i%4 == 0 {
+			d.Tricks = append(d.Tricks, cards.PlayedTrick{Leader: seats[p.PlayerId]})
+		}
+		t := &d.Tricks[len(d.Tricks)-1]
+		t.Cards = append(t.Cards, p.Card)
+	}
+	d.Scores = append(d.Scores, h.HandScores...)
+	return d
+}
+
+// Reads a game's history from its proto, such as one from GetGameHistory.
+func HistoryFromProto(hp *pb.GameHistory) (GameHistory, error) {
+	if hp.GetPhase() != pb.GameState_Completed && hp.GetPhase() != pb.GameState_Aborted {
+		return GameHistory{}, fmt.Errorf("game %s isn't over", hp.GetGameId())
+	}
+	h := GameHistory{
+		Id:    hp.GetGameId(),
+		Phase: protoToPhase(hp.GetPhase()),
+	}
+	for _, p := range hp.GetPlayers() {
+		h.Players = append(h.Players, HistoryPlayer{Id: p.GetId(), Name: p.GetName(), IsBot: p.GetIsBot()})
+	}
+	for _, e := range hp.GetEvents() {
+		t := time.UnixMilli(e.GetTime())
+		if h.Start.IsZero() {
+			h.Start = t
+		}
+		h.End = t
+		switch et := e.GetType().(type) {
+		case *pb.GameHistory_Event_Deal:
+			for _, hand := range et.Deal.GetHands() {
+				cs, err := cards.ParseCards(hand.GetCards())
+				if err != nil {
+					return GameHistory{}, err
+				}
+				h.Hands = append(h.Hands, cs)
+			}
+		case *pb.GameHistory_Event_CardPlayed:
+			card, err := cards.ParseCard(et.CardPlayed.GetCard())
+			if err != nil {
+				return GameHistory{}, err
+			}
+			h.Plays = append(h.Plays, Play{
+				Time:       t,
+				Card:       card,
+				PlayerId:   et.CardPlayed.GetPlayerId(),
+				PlayerName: et.CardPlayed.GetPlayerName(),
+			})
+		case *pb.GameHistory_Event_TrickCompleted:
+			trick, err := cards.ParseCards(et.TrickCompleted.GetTrick())
+			if err != nil {
+				return GameHistory{}, err
+			}
+			winningCard, err := cards.ParseCard(et.TrickCompleted.GetWinningCard())
+			if err != nil {
+				return GameHistory{}, err
+			}
+			h.Tricks = append(h.Tricks, TrickResult{
+				Time:        t,
+				Trick:       trick,
+				WinningCard: winningCard,
+				WinnerId:    et.TrickCompleted.GetWinnerId(),
+				WinnerName:  et.TrickCompleted.GetWinnerName(),
+			})
+		case *pb.GameHistory_Event_GameFinished:
+			for _, score := range et.GameFinished.GetHandScores() {
+				h.HandScores = append(h.HandScores, int(score))
+			}
+		}
+	}
+	if len(h.Players) != 4 {
+		return GameHistory{}, fmt.Errorf("game %s has %d players, want 4", h.Id, len(h.Players))
+	}
+	if len(h.Hands) != len(h.Players) {
+		return GameHistory{}, fmt.Errorf("game %s has %d hands dealt to %d players", h.Id, len(h.Hands), len(h.Players))
+	}
+	if len(h.Plays) < 4*len(h.Tricks) {
+		return GameHistory{}, fmt.Errorf("game %s has %d tricks from %d cards", h.Id, len(h.Tricks), len(h.Plays))
+	}
+	if len(h.HandScores) > 0 && len(h.HandScores) != len(h.Players) {
+		return GameHistory{}, fmt.Errorf("game %s has %d scores for %d players", h.Id, len(h.HandScores), len(h.Players))
+	}
+	return h, nil
+}
+
+// Reads a game's history from a file kept by a server.
+func ReadHistoryFile(path string) (GameHistory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return GameHistory{}, err
+	}
+	hp := &pb.GameHistory{}
+	if err := proto.Unmarshal(data, hp); err != nil {
+		return GameHistory{}, fmt.Errorf("can't read %s: %w", path, err)
+	}
+	return HistoryFromProto(hp)
+}
+
+type PastGameSummary struct {
+	Id         string
+	Phase      GamePhase
+	Names      []string
+	HandScores []int // In the order of Names. Empty if the game was aborted.
+	Start      time.Time
+	End        time.Time
+}
+
+// Chooses the games that ListPastGames returns. Zero fields match every game.
+type PastGamesFilter struct {
+	PlayerName    string
+	StartedAfter  time.Time
+	StartedBefore time.Time
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func (c *connection) GetGameHistory(ctx context.Context, gameId string) (GameHistory, error) {
+	resp, err := c.client.GetGameHistory(ctx, &pb.GameHistoryRequest{GameId: gameId})
+	if err != nil {
+		return GameHistory{}, err
+	}
+	return HistoryFromProto(resp)
+}
+
+func (c *connection) ListPastGames(ctx context.Context, filter PastGamesFilter) ([]PastGameSummary, error) {
+	req := &pb.ListPastGamesRequest{
+		PlayerName:    filter.PlayerName,
+		StartedAfter:  unixMilli(filter.StartedAfter),
+		StartedBefore: unixMilli(filter.StartedBefore),
+	}
+	resp, err := c.client.ListPastGames(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var games []PastGameSummary
+	for _, g := range resp.GetGames() {
+		var scores []int
+		for _, score := range g.GetHandScores() {
+			scores = append(scores, int(score))
+		}
+		games = append(games, PastGameSummary{
+			Id:         g.GetId(),
+			Phase:      protoToPhase(g.GetPhase()),
+			Names:      g.GetPlayerNames(),
+			HandScores: scores,
+			Start:      time.UnixMilli(g.GetStartTime()),
+			End:        time.UnixMilli(g.GetEndTime()),
+		})
+	}
+	return games, nil
+}
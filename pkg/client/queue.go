@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"google.golang.org/grpc"
+)
+
+// Chooses the game JoinQueue waits for. Zero fields match any player for standard hearts.
+type QueueOptions struct {
+	GameType     string
+	Rules        string
+	MaxRatingGap float64 // Only play with players whose ratings are within this much of yours.
+	// Called with the number of players at the table being formed, including you, each time it changes.
+	Waiting func(players, seats int)
+}
+
+func (s *session) JoinQueue(ctx context.Context, wg *sync.WaitGroup, opts QueueOptions) (string, error) {
+	req := &pb.JoinQueueRequest{
+		SessionId:    s.sessionId,
+		GameType:     opts.GameType,
+		Rules:        opts.Rules,
+		MaxRatingGap: opts.MaxRatingGap,
+	}
+	qctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.client.JoinQueue(qctx, req)
+	if err != nil {
+		return "", err
+	}
+	for {
+		activity, err := stream.Recv()
+		if err == io.EOF {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			return "", fmt.Errorf("the queue closed before a game was found")
+		}
+		if err != nil {
+			return "", err
+		}
+		if w := activity.GetWaiting(); w != nil {
+			if opts.Waiting != nil {
+				opts.Waiting(int(w.GetPlayers()), int(w.GetSeats()))
+			}
+			continue
+		}
+		gameId := activity.GetGameAssigned().GetGameId()
+		if gameId == "" {
+			continue
+		}
+		if s.verbose {
+			fmt.Printf("Queue assigned game %s\n", gameId)
+		}
+		return gameId, s.JoinGame(ctx, wg, gameId)
+	}
+}
+
+// The server stream ends when ctx is done.
+func makeQueueLocalConnectors(ctx context.Context) (*localQueueActivityClient, pb.CardGameService_JoinQueueServer) {
+	ch := make(chan *pb.QueueActivity)
+	client := &localQueueActivityClient{ch: ch, ctx: ctx, done: make(chan struct{})}
+	server := &localQueueActivityServer{ch: ch, svrctx: ctx}
+	return client, server
+}
+
+type localQueueActivityServer struct {
+	grpc.ServerStream
+	ch     chan *pb.QueueActivity
+	svrctx context.Context
+}
+
+func (s localQueueActivityServer) Context() context.Context {
+	return s.svrctx
+}
+
+func (s localQueueActivityServer) Send(qa *pb.QueueActivity) error {
+	select {
+	case s.ch <- qa:
+		return nil
+	case <-s.svrctx.Done():
+		return s.svrctx.Err()
+	}
+}
+
+type localQueueActivityClient struct {
+	grpc.ClientStream
+	ch   chan *pb.QueueActivity
+	ctx  context.Context
+	done chan struct{} // Closed when the server has finished the stream.
+	err  error         // Why the server finished the stream, set before done is closed.
+}
+
+// Records that the server has finished the stream, with err if it failed.
+func (c *localQueueActivityClient) finish(err error) {
+	if c.ctx.Err() != nil || err == nil {
+		err = io.EOF
+	}
+	c.err = err
+	close(c.done)
+}
+
+func (c *localQueueActivityClient) Recv() (*pb.QueueActivity, error) {
+	select {
+	case qa := <-c.ch:
+		return qa, nil
+	case <-c.ctx.Done():
+		return nil, io.EOF
+	case <-c.done:
+		return nil, c.err
+	}
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// A player's totals over the completed games they started.
+type PlayerStats struct {
+	Name               string
+	GamesPlayed        int
+	Wins               int // Games with the lowest hand score, including ties.
+	TotalHandScore     int
+	MoonShots          int
+	QueenOfSpadesTaken int
+	Rating             float64
+}
+
+func (ps PlayerStats) WinRate() float64 {
+	if ps.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(ps.Wins) / float64(ps.GamesPlayed)
+}
+
+func (ps PlayerStats) AverageHandScore() float64 {
+	if ps.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(ps.TotalHandScore) / float64(ps.GamesPlayed)
+}
+
+func (ps PlayerStats) String() string {
+	return fmt.Sprintf("%s: rating %.0f, %d games, %d wins (%.0f%%), average hand score %.1f, %d moon shots, took Q♠ %d times",
+		ps.Name, ps.Rating, ps.GamesPlayed, ps.Wins, 100*ps.WinRate(), ps.AverageHandScore(), ps.MoonShots, ps.QueenOfSpadesTaken)
+}
+
+type LeaderboardOrder int8
+
+const (
+	ByWinRate LeaderboardOrder = iota
+	ByAverageHandScore
+	ByGamesPlayed
+	ByMoonShots
+	ByRating
+)
+
+// Chooses how GetLeaderboard ranks players. Zero fields rank every player by win rate.
+type LeaderboardOptions struct {
+	Order    LeaderboardOrder
+	MinGames int // Only players who completed at least this many games.
+	Limit    int // At most this many players.
+}
+
+func statsFromProto(ps *pb.PlayerStats) PlayerStats {
+	return PlayerStats{
+		Name:               ps.GetName(),
+		GamesPlayed:        int(ps.GetGamesPlayed()),
+		Wins:               int(ps.GetWins()),
+		TotalHandScore:     int(ps.GetTotalHandScore()),
+		MoonShots:          int(ps.GetMoonShots()),
+		QueenOfSpadesTaken: int(ps.GetQueenOfSpadesTaken()),
+		Rating:             ps.GetRating(),
+	}
+}
+
+func (c *connection) GetPlayerStats(ctx context.Context, name string) (PlayerStats, error) {
+	resp, err := c.client.GetPlayerStats(ctx, &pb.PlayerStatsRequest{PlayerName: name})
+	if err != nil {
+		return PlayerStats{}, err
+	}
+	return statsFromProto(resp), nil
+}
+
+func (c *connection) GetLeaderboard(ctx context.Context, opts LeaderboardOptions) ([]PlayerStats, error) {
+	req := &pb.LeaderboardRequest{
+		Order:    pb.LeaderboardRequest_Order(opts.Order),
+		MinGames: int32(opts.MinGames),
+		Limit:    int32(opts.Limit),
+	}
+	resp, err := c.client.GetLeaderboard(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var players []PlayerStats
+	for _, ps := range resp.GetPlayers() {
+		players = append(players, statsFromProto(ps))
+	}
+	return players, nil
+}
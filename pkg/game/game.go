@@ -12,10 +12,16 @@ type Game interface {
 	Phase() GamePhase
 	GetLastActivityTime() time.Time
 	AcceptingMorePlayers() bool
-	AddPlayer(name, sessionId string)
+	// Seats the player in the first empty seat, from North.
+	AddPlayer(name, sessionId string, isBot bool)
+	// Moves a player to an empty seat before the game starts.
+	ChooseSeat(sessionId string, seat cards.Seat) error
 	PlayerNames() []string
 	NextPlayerId() string
+	HasPlayer(sessionId string) bool
 	RemovePlayer(sessionId string) error
+	// Changes who is shown in a player's seat, e.g. when a bot takes it over during play.
+	UpdatePlayer(sessionId, name string, isBot bool) error
 	IsEnoughPlayersToStart() bool
 	ConfirmPlayerReadyToStart(sessionId string) error
 	UnconfirmedPlayerIds() []string
@@ -23,6 +29,8 @@ type Game interface {
 	GetGameState(sessionId string) (*pb.GameState, error)
 	HandlePlayCard(sessionId string, card cards.Card, reporter Reporter) error
 	Abort()
+	// Returns the game's state, for saving. Each game type has a function to restore it.
+	Snapshot() ([]byte, error)
 }
 
 type GamePhase int8
@@ -54,7 +62,7 @@ type Reporter interface {
 	ReportPlayerJoined(g Game, name string)
 	ReportPlayerLeft(g Game, name string)
 	ReportGameStarted(g Game)
-	ReportCardPlayed(g Game)
+	ReportCardPlayed(g Game, card cards.Card, playerId, playerName string)
 	ReportTrickCompleted(g Game, trick cards.Cards, winningCard cards.Card, trickWinnerId, trickWinnerName string)
 	ReportGameFinished(g Game)
 	ReportGameAborted(g Game)
@@ -3,6 +3,7 @@ package hearts
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/mpsalisbury/cards/pkg/cards"
@@ -20,16 +21,39 @@ func NewGame(gameId string) game.Game {
 	}
 }
 
+// Creates a game whose players are dealt the given hands by seat, from North,
+// instead of a random deal.
+func NewGameWithDeal(gameId string, hands []cards.Cards) (game.Game, error) {
+	if len(hands) != 4 {
+		return nil, fmt.Errorf("deal has %d hands, want 4", len(hands))
+	}
+	all := cards.Combine(hands...)
+	for _, h := range hands {
+		if len(h) != 13 {
+			return nil, fmt.Errorf("deal has a hand with %d cards, want 13", len(h))
+		}
+	}
+	if !all.Equals(cards.MakeDeck()) {
+		return nil, fmt.Errorf("deal is not a full deck")
+	}
+	g := NewGame(gameId).(*heartsGame)
+	for _, h := range hands {
+		g.presetHands = append(g.presetHands, h.Copy())
+	}
+	return g, nil
+}
+
 type heartsGame struct {
 	id               string
 	lastActivityTime time.Time
 	phase            game.GamePhase
 	players          map[string]*player // Keyed by sessionId/playerId
-	playerOrder      []string           // by sessionId/playerId
+	playerOrder      []string           // by sessionId/playerId, in seat order from North
 	numTricksPlayed  int
 	currentTrick     *trick
 	nextPlayerIndex  int // index into playerOrder
 	heartsBroken     bool
+	presetHands      []cards.Cards // if present, dealt instead of shuffling
 }
 
 func (g heartsGame) Id() string {
@@ -90,21 +114,80 @@ func (g heartsGame) AcceptingMorePlayers() bool {
 	return len(g.players) < 4
 }
 
-func (g *heartsGame) AddPlayer(name string, id string) {
+func (g *heartsGame) AddPlayer(name string, id string, isBot bool) {
 	g.touch()
-	p := &player{id: id, name: name}
+	p := &player{id: id, name: name, isBot: isBot, seat: g.emptySeat()}
 	g.players[id] = p
 	g.playerOrder = append(g.playerOrder, id)
+	g.sortBySeat()
 }
-func (g heartsGame) containsPlayer(playerId string) bool {
+
+// Returns the first empty seat, from North.
+func (g heartsGame) emptySeat() cards.Seat {
+	for _, seat := range cards.Seats {
+		if g.playerIn(seat) == nil {
+			return seat
+		}
+	}
+	return cards.NoSeat
+}
+
+// Returns the player sitting in seat, or nil if it's empty.
+func (g heartsGame) playerIn(seat cards.Seat) *player {
+	for _, p := range g.players {
+		if p.seat == seat {
+			return p
+		}
+	}
+	return nil
+}
+
+func (g *heartsGame) ChooseSeat(playerId string, seat cards.Seat) error {
+	g.touch()
+	p, ok := g.players[playerId]
+	if !ok {
+		return fmt.Errorf("no player %s found", playerId)
+	}
+	if g.phase != game.Preparing {
+		return fmt.Errorf("can't change seats once the game has started")
+	}
+	if seat < cards.North || seat > cards.West {
+		return fmt.Errorf("no such seat %d", seat)
+	}
+	if other := g.playerIn(seat); other != nil && other != p {
+		return fmt.Errorf("%s is already sitting %s", other.name, seat.Name())
+	}
+	p.seat = seat
+	g.sortBySeat()
+	return nil
+}
+
+// Puts playerOrder in turn order, from North.
+func (g *heartsGame) sortBySeat() {
+	sort.Slice(g.playerOrder, func(i, j int) bool {
+		return g.players[g.playerOrder[i]].seat < g.players[g.playerOrder[j]].seat
+	})
+}
+func (g heartsGame) HasPlayer(playerId string) bool {
 	_, ok := g.players[playerId]
 	return ok
 }
 
+func (g *heartsGame) UpdatePlayer(playerId, name string, isBot bool) error {
+	g.touch()
+	p, ok := g.players[playerId]
+	if !ok {
+		return fmt.Errorf("no player %s found", playerId)
+	}
+	p.name = name
+	p.isBot = isBot
+	return nil
+}
+
 // Remove player if present
 func (g *heartsGame) RemovePlayer(playerId string) error {
 	g.touch()
-	if !g.containsPlayer(playerId) {
+	if !g.HasPlayer(playerId) {
 		return nil
 	}
 	if g.phase != game.Preparing {
@@ -177,9 +260,14 @@ func (g heartsGame) UnconfirmedPlayerIds() []string {
 
 func (g *heartsGame) StartGame() {
 	g.touch()
-	for i, h := range cards.Deal(4) {
+	hands := g.presetHands
+	if len(hands) == 0 {
+		hands = cards.Deal(4)
+	}
+	for i, h := range hands {
 		playerId := g.playerOrder[i]
-		g.players[playerId].cards = h
+		g.players[playerId].cards = h.Copy()
+		g.players[playerId].cards.Sort()
 	}
 	g.nextPlayerIndex = g.findPlayerIndexWithCard(cards.C2c)
 	g.phase = game.Playing
@@ -206,6 +294,15 @@ func (g heartsGame) NextPlayerId() string {
 
 func (g heartsGame) GetGameState(playerId string) (*pb.GameState, error) {
 	_, requesterIsPlayer := g.players[playerId]
+	if g.phase == game.Preparing {
+		// Only who sits where, for choosing a seat.
+		gs := &pb.GameState{Id: g.id, Phase: g.phase.ToProto()}
+		for _, id := range g.playerOrder {
+			p := g.players[id]
+			gs.Players = append(gs.Players, &pb.GameState_Player{Id: p.id, Name: p.name, IsBot: p.isBot, Seat: p.seat.ToProto()})
+		}
+		return gs, nil
+	}
 	if g.phase != game.Playing && g.phase != game.Completed {
 		return &pb.GameState{Phase: g.phase.ToProto()}, nil
 	}
@@ -230,6 +327,8 @@ func (g heartsGame) GetGameState(playerId string) (*pb.GameState, error) {
 type player struct {
 	id             string
 	name           string
+	isBot          bool
+	seat           cards.Seat
 	isReadyToStart bool
 	cards          cards.Cards
 	tricks         []cards.Cards
@@ -247,6 +346,8 @@ func (g heartsGame) playerState(p *player, hideOther bool) *pb.GameState_Player
 		TrickScore:   int32(p.trickScore),
 		HandScore:    int32(p.handScore),
 		IsNextPlayer: p.id == g.playerOrder[g.nextPlayerIndex],
+		IsBot:        p.isBot,
+		Seat:         p.seat.ToProto(),
 	}
 	if !hideOther {
 		ps.Cards = p.cards.ToProto()
@@ -309,7 +410,7 @@ func (g *heartsGame) HandlePlayCard(playerId string, card cards.Card, r game.Rep
 	}
 	p.cards = p.cards.Remove(card)
 	g.currentTrick.addCard(card, p.id)
-	r.ReportCardPlayed(g)
+	r.ReportCardPlayed(g, card, p.id, p.name)
 
 	if g.currentTrick.size() < 4 {
 		g.nextPlayerIndex = (g.nextPlayerIndex + 1) % 4
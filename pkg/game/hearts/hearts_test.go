@@ -0,0 +1,65 @@
+package hearts
+
+import (
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+func TestChooseSeat(t *testing.T) {
+	deck := cards.MakeDeck()
+	var hands []cards.Cards
+	for i := 0; i < 4; i++ {
+		hands = append(hands, deck[13*i:13*(i+1)])
+	}
+	g, err := NewGameWithDeal("g0001", hands)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.AddPlayer("ann", "p1", false)
+	g.AddPlayer("bob", "p2", false)
+	if err := g.ChooseSeat("p2", cards.North); err == nil {
+		t.Errorf("bob took ann's seat")
+	}
+	if err := g.ChooseSeat("p2", cards.South); err != nil {
+		t.Fatal(err)
+	}
+	g.AddPlayer("cat", "p3", false)
+	g.AddPlayer("dan", "p4", true)
+
+	gs, err := g.GetGameState("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]pb.GameState_Seat{"ann": pb.GameState_North, "cat": pb.GameState_East, "bob": pb.GameState_South, "dan": pb.GameState_West}
+	for _, p := range gs.GetPlayers() {
+		if p.GetSeat() != want[p.GetName()] {
+			t.Errorf("%s is sitting %s, want %s", p.GetName(), p.GetSeat(), want[p.GetName()])
+		}
+	}
+
+	g.StartGame()
+	if err := g.ChooseSeat("p1", cards.West); err == nil {
+		t.Errorf("ann changed seats after the game started")
+	}
+	// Hands are dealt from North, and play passes clockwise from each player.
+	gs, err = g.GetGameState("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, p := range gs.GetPlayers() {
+		names = append(names, p.GetName())
+	}
+	if len(names) != 4 || names[0] != "bob" || names[1] != "dan" || names[2] != "ann" || names[3] != "cat" {
+		t.Errorf("players in order from bob are %v, want [bob dan ann cat]", names)
+	}
+	mine, err := cards.ParseCards(gs.GetPlayers()[0].GetCards().GetCards())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mine.Equals(hands[2]) {
+		t.Errorf("South was dealt %s, want %s", mine, hands[2])
+	}
+}
@@ -0,0 +1,203 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"github.com/mpsalisbury/cards/pkg/server"
+)
+
+// How hard AnalyzeGame looks at each decision.
+var DefaultAnalysisConfig = EvaluationConfig{
+	Samples:    200,
+	TimeBudget: 250 * time.Millisecond,
+}
+
+// Expected points lost, compared with the best play, that make a play an inaccuracy or a blunder.
+const (
+	inaccuracyLoss = 1.0
+	blunderLoss    = 4.0
+)
+
+// A play expected to take fewer points than this is safe.
+const safePoints = 0.5
+
+// Returns a review of every play in d where there was a choice of card. Each decision is
+// evaluated from what the player could see then, and the card they chose is graded
+// against the best of the legal plays.
+func AnalyzeGame(d cards.DealRecord, config EvaluationConfig) (client.GameAnalysis, error) {
+	return analyzeDeal(context.Background(), d, nil, config)
+}
+
+// Returns a review of h like AnalyzeGame's. Each decision is credited to whoever played
+// the card, so a bot that took over a seat answers for its own plays. Stops with ctx's
+// error if ctx is done first.
+func AnalyzeHistory(ctx context.Context, h client.GameHistory, config EvaluationConfig) (client.GameAnalysis, error) {
+	var players []string
+	for _, p := range h.Plays {
+		players = append(players, p.PlayerName)
+	}
+	a, err := analyzeDeal(ctx, h.DealRecord(), players, config)
+	if err != nil {
+		return client.GameAnalysis{}, err
+	}
+	a.Id = h.Id
+	return a, nil
+}
+
+// Analyzes d, crediting play i to players[i], or to the name of its seat in d if there's
+// no name for it.
+func analyzeDeal(ctx context.Context, d cards.DealRecord, players []string, config EvaluationConfig) (client.GameAnalysis, error) {
+	// A fixed seed makes the samples the same each time the game is analyzed.
+	rng := rand.New(rand.NewSource(1))
+	var a client.GameAnalysis
+	var losses []float64
+	// Index in a.Players of whoever played in each seat, keyed by seat and name.
+	type seatPlayer struct {
+		seat cards.Seat
+		name string
+	}
+	index := make(map[seatPlayer]int)
+	playerIndex := func(seat cards.Seat, name string) int {
+		i, ok := index[seatPlayer{seat, name}]
+		if !ok {
+			i = len(a.Players)
+			index[seatPlayer{seat, name}] = i
+			a.Players = append(a.Players, client.PlayerAccuracy{Seat: seat, Name: name})
+			losses = append(losses, 0)
+		}
+		return i
+	}
+	for _, s := range cards.Seats {
+		playerIndex(s, d.Names[s])
+	}
+	pos, err := hearts.PositionFromDeal(d, 0)
+	if err != nil {
+		return client.GameAnalysis{}, err
+	}
+	var tricks [4][]cards.Cards
+	play := 0
+	for _, t := range d.Tricks {
+		for _, c := range t.Cards {
+			play++
+			seat := pos.NextSeat()
+			gs := GameStateForSeat(pos, tricks, seat)
+			if !gs.LegalPlays.ContainsCard(c) {
+				return client.GameAnalysis{}, fmt.Errorf("play %d: %s can't play %s", play, cards.Seat(seat).Name(), c)
+			}
+			if len(gs.LegalPlays) > 1 {
+				if err := ctx.Err(); err != nil {
+					return client.GameAnalysis{}, err
+				}
+				evals := evaluatePlays(gs, newKnowledge(gs), config, rng)
+				decision := grade(evals, c, t, cards.Seat(seat))
+				decision.Play = play
+				decision.Name = d.Names[seat]
+				if play <= len(players) && players[play-1] != "" {
+					decision.Name = players[play-1]
+				}
+				a.Decisions = append(a.Decisions, decision)
+				i := playerIndex(cards.Seat(seat), decision.Name)
+				p := &a.Players[i]
+				p.Decisions++
+				switch decision.Grade {
+				case client.Inaccuracy:
+					p.Inaccuracies++
+				case client.Blunder:
+					p.Blunders++
+				}
+				losses[i] += decision.ExpectedPoints - decision.BestExpectedPoints
+			}
+			trick := append(pos.Trick.Copy(), c)
+			pos.Play(c)
+			if len(trick) == 4 {
+				tricks[pos.Leader] = append(tricks[pos.Leader], trick)
+			}
+		}
+	}
+	for i := range a.Players {
+		p := &a.Players[i]
+		if p.Decisions == 0 {
+			p.Accuracy = 100
+			continue
+		}
+		p.AverageLoss = losses[i] / float64(p.Decisions)
+		p.Accuracy = 100 * float64(p.Decisions-p.Inaccuracies-p.Blunders) / float64(p.Decisions)
+	}
+	// Anyone who took over a seat follows its first player.
+	sort.SliceStable(a.Players, func(i, j int) bool { return a.Players[i].Seat < a.Players[j].Seat })
+	return a, nil
+}
+
+// Grades the play of card c in trick t by seat, given the evaluations of the legal plays, best first.
+func grade(evals []PlayEvaluation, c cards.Card, t cards.PlayedTrick, seat cards.Seat) client.Decision {
+	best := evals[0]
+	var played PlayEvaluation
+	for _, e := range evals {
+		if e.Card == c {
+			played = e
+		}
+	}
+	d := client.Decision{
+		Seat:               seat,
+		Card:               c,
+		ExpectedPoints:     played.ExpectedPoints,
+		Best:               best.Card,
+		BestExpectedPoints: best.ExpectedPoints,
+	}
+	loss := played.ExpectedPoints - best.ExpectedPoints
+	switch {
+	case loss >= blunderLoss:
+		d.Grade = client.Blunder
+	case loss >= inaccuracyLoss:
+		d.Grade = client.Inaccuracy
+	default:
+		return d
+	}
+	n := cards.UnicodeNotation
+	what := fmt.Sprintf("played %s", n.Card(c))
+	if len(t.Cards) == 4 && t.Winner() == seat {
+		switch points := trickScore(t.Cards); {
+		case c == cards.Cqs:
+			what = fmt.Sprintf("took the trick with %s", n.Card(c))
+		case t.Cards.ContainsCard(cards.Cqs):
+			what = fmt.Sprintf("took %s with %s", n.Card(cards.Cqs), n.Card(c))
+		case points == 1:
+			what = fmt.Sprintf("took a point with %s", n.Card(c))
+		case points > 1:
+			what = fmt.Sprintf("took %d points with %s", points, n.Card(c))
+		}
+	}
+	if best.ExpectedPoints < safePoints {
+		d.Comment = fmt.Sprintf("%s when %s was safe", what, n.Card(best.Card))
+	} else {
+		d.Comment = fmt.Sprintf("%s when %s was expected to take %.1f points", what, n.Card(best.Card), best.ExpectedPoints)
+	}
+	return d
+}
+
+// Returns a server.GameAnalyzer that analyzes games with AnalyzeGame.
+func NewGameAnalyzer() server.GameAnalyzer {
+	return gameAnalyzer{}
+}
+
+type gameAnalyzer struct{}
+
+func (gameAnalyzer) AnalyzeGame(ctx context.Context, hp *pb.GameHistory) (*pb.GameAnalysis, error) {
+	h, err := client.HistoryFromProto(hp)
+	if err != nil {
+		return nil, err
+	}
+	a, err := AnalyzeHistory(ctx, h, DefaultAnalysisConfig)
+	if err != nil {
+		return nil, err
+	}
+	return a.ToProto(), nil
+}
@@ -0,0 +1,134 @@
+package player
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// Returns a deal played out with every player playing their highest legal card.
+func highCardDeal(t *testing.T) cards.DealRecord {
+	d := cards.NewRandomDeal(1)
+	d.Names = [4]string{"ann", "bob", "cat", "dan"}
+	pos, err := hearts.PositionFromDeal(d, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for !pos.IsOver() {
+		if len(pos.Trick) == 0 {
+			d.Tricks = append(d.Tricks, cards.PlayedTrick{Leader: cards.Seat(pos.NextSeat())})
+		}
+		legalPlays := pos.LegalPlays()
+		c := legalPlays[len(legalPlays)-1]
+		tr := &d.Tricks[len(d.Tricks)-1]
+		tr.Cards = append(tr.Cards, c)
+		pos.Play(c)
+	}
+	return d
+}
+
+func TestAnalyzeGame(t *testing.T) {
+	d := highCardDeal(t)
+	a, err := AnalyzeGame(d, EvaluationConfig{Samples: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Decisions) == 0 || len(a.Decisions) > 52 {
+		t.Fatalf("got %d decisions", len(a.Decisions))
+	}
+	var decisions [4]int
+	lastPlay := 0
+	for _, dec := range a.Decisions {
+		if dec.Play <= lastPlay {
+			t.Errorf("play %d follows play %d", dec.Play, lastPlay)
+		}
+		lastPlay = dec.Play
+		decisions[dec.Seat]++
+		if dec.Name != d.Names[dec.Seat] {
+			t.Errorf("play %d by %s, want %s", dec.Play, dec.Name, d.Names[dec.Seat])
+		}
+		loss := dec.ExpectedPoints - dec.BestExpectedPoints
+		if loss < 0 {
+			t.Errorf("play %d: %s is better than the best play %s", dec.Play, dec.Card, dec.Best)
+		}
+		want := client.Good
+		switch {
+		case loss >= blunderLoss:
+			want = client.Blunder
+		case loss >= inaccuracyLoss:
+			want = client.Inaccuracy
+		}
+		if dec.Grade != want {
+			t.Errorf("play %d losing %.1f points graded %s, want %s", dec.Play, loss, dec.Grade, want)
+		}
+		if (dec.Comment != "") != (dec.Grade != client.Good) {
+			t.Errorf("play %d graded %s has comment %q", dec.Play, dec.Grade, dec.Comment)
+		}
+	}
+	if len(a.Players) != 4 {
+		t.Fatalf("got %d players, want 4", len(a.Players))
+	}
+	for i, p := range a.Players {
+		if p.Seat != cards.Seat(i) || p.Name != d.Names[i] {
+			t.Errorf("player %d is %s %s", i, p.Seat, p.Name)
+		}
+		if p.Decisions != decisions[i] {
+			t.Errorf("%s made %d decisions, want %d", p.Name, p.Decisions, decisions[i])
+		}
+		if p.Accuracy < 0 || p.Accuracy > 100 {
+			t.Errorf("%s has accuracy %f", p.Name, p.Accuracy)
+		}
+	}
+}
+
+func TestAnalyzeGameIllegalPlay(t *testing.T) {
+	d := highCardDeal(t)
+	// Swap the first two cards of the second trick, so someone fails to follow suit or plays out of turn.
+	tr := &d.Tricks[1]
+	tr.Cards[0], tr.Cards[1] = tr.Cards[1], tr.Cards[0]
+	if _, err := AnalyzeGame(d, EvaluationConfig{Samples: 10}); err == nil {
+		t.Errorf("AnalyzeGame succeeded with an illegal play")
+	}
+}
+
+func TestAnalyzeHistoryCreditsWhoPlayed(t *testing.T) {
+	d := highCardDeal(t)
+	h := client.GameHistory{Id: "g1"}
+	for i, name := range d.Names {
+		h.Players = append(h.Players, client.HistoryPlayer{Id: name, Name: name})
+		h.Hands = append(h.Hands, d.Hands[i])
+	}
+	// A bot takes over ann's seat after six tricks.
+	for i, tr := range d.Tricks {
+		for j, c := range tr.Cards {
+			seat := tr.Leader.Next(j)
+			name := d.Names[seat]
+			if seat == cards.North && i >= 6 {
+				name = "basic bot"
+			}
+			h.Plays = append(h.Plays, client.Play{Card: c, PlayerId: d.Names[seat], PlayerName: name})
+		}
+	}
+	a, err := AnalyzeHistory(context.Background(), h, EvaluationConfig{Samples: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decisions := make(map[string]int)
+	for _, dec := range a.Decisions {
+		if want := h.Plays[dec.Play-1].PlayerName; dec.Name != want {
+			t.Errorf("play %d credited to %s, want %s", dec.Play, dec.Name, want)
+		}
+		decisions[dec.Name]++
+	}
+	if len(a.Players) != 5 || a.Players[0].Name != "ann" || a.Players[1].Name != "basic bot" || a.Players[1].Seat != cards.North {
+		t.Fatalf("players are %+v, want ann then the bot in North, then the others", a.Players)
+	}
+	for _, p := range a.Players {
+		if p.Decisions != decisions[p.Name] {
+			t.Errorf("%s made %d decisions, want %d", p.Name, p.Decisions, decisions[p.Name])
+		}
+	}
+}
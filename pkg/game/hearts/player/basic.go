@@ -1,6 +1,8 @@
 package player
 
 import (
+	"fmt"
+
 	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
 	"golang.org/x/exp/maps"
@@ -12,18 +14,35 @@ func newBasicStrategy() PlayerStrategy {
 
 // Publicly expose basic strategy.
 func ChooseBasicStrategyCard(gs client.GameState) cards.Card {
-	return newBasicStrategy().ChooseCardToPlay(gs)
+	return ExplainBasicStrategyCard(gs).Card
+}
+
+// Returns basic strategy's card along with the reason for playing it.
+func ExplainBasicStrategyCard(gs client.GameState) Explanation {
+	return basicStrategy{}.ExplainCardToPlay(gs)
 }
 
 type basicStrategy struct{}
 
 func (s basicStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	return s.ExplainCardToPlay(gs).Card
+}
+
+func (s basicStrategy) ExplainCardToPlay(gs client.GameState) Explanation {
 	legalPlays := gs.LegalPlays
 	trick := gs.CurrentTrick
 
 	// Play only valid card. This includes leading 2c.
 	if len(legalPlays) == 1 {
-		return legalPlays[0]
+		if legalPlays[0] == cards.C2c && len(trick) == 0 {
+			return explain(cards.C2c, "lead-2c", "The %s always leads the first trick", cardName(cards.C2c))
+		}
+		return explain(legalPlays[0], "only-play", "The %s is your only legal play", cardName(legalPlays[0]))
+	}
+
+	// Go for the moon, or stop someone else getting there.
+	if e, ok := chooseMoonCard(gs); ok {
+		return e
 	}
 
 	haveLead := len(trick) == 0
@@ -45,7 +64,7 @@ func (s basicStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
 	return chooseDumpCard(gs)
 }
 
-func chooseLeadCard(gs client.GameState) cards.Card {
+func chooseLeadCard(gs client.GameState) Explanation {
 	legalPlays := gs.LegalPlays
 	if qsNotYetPlayed(gs) {
 		//   If we have qs, ks, or as, ...
@@ -53,26 +72,29 @@ func chooseLeadCard(gs client.GameState) cards.Card {
 			// ... lead lowest card in non-spade suit,
 			nonSpades := legalPlays.FilterBySuit(cards.Hearts, cards.Diamonds, cards.Clubs)
 			if len(nonSpades) > 0 {
-				return nonSpades.Lowest()
+				return explain(nonSpades.Lowest(), "lead-low-nonspade",
+					"Leading your lowest non-spade so your high spades stay out of spade tricks while the %s is out", cardName(cards.Cqs))
 			}
 			// or lead lowest nonQueen spade, (remaining cards are all spades)
 			nonQueenSpades := legalPlays.Filter(func(c cards.Card) bool { return c != cards.Cqs })
 			if len(nonQueenSpades) > 0 {
-				return nonQueenSpades.Lowest()
+				return explain(nonQueenSpades.Lowest(), "lead-low-spade",
+					"You only have spades, so leading your lowest one other than the %s", cardName(cards.Cqs))
 			}
 			// or lead Qs
-			return cards.Cqs
+			return explain(cards.Cqs, "lead-queen", "The %s is all you have left to lead", cardName(cards.Cqs))
 		}
 		// If we have a spade (but not qka), lead highest spade
 		if legalPlays.ContainsSuit(cards.Spades) {
-			return legalPlays.FilterBySuit(cards.Spades).Highest()
+			return explain(legalPlays.FilterBySuit(cards.Spades).Highest(), "flush-queen",
+				"Leading your highest spade to flush out the %s, which you can't be stuck with", cardName(cards.Cqs))
 		}
 	}
 	// Lead lowest card
-	return legalPlays.Lowest()
+	return explain(legalPlays.Lowest(), "lead-low", "Leading your lowest card, which is least likely to win the trick")
 }
 
-func followSpadesWhenQueenOutstanding(gs client.GameState) cards.Card {
+func followSpadesWhenQueenOutstanding(gs client.GameState) Explanation {
 	legalPlays := gs.LegalPlays
 	trick := gs.CurrentTrick
 
@@ -80,31 +102,36 @@ func followSpadesWhenQueenOutstanding(gs client.GameState) cards.Card {
 	if legalPlays.ContainsCard(cards.Cqs) {
 		// if as or ks is already in the trick, play qs
 		if trick.ContainsAny(cards.Cks, cards.Cas) {
-			return cards.Cqs
+			return explain(cards.Cqs, "drop-queen", "Dropping the %s under the %s already in the trick",
+				cardName(cards.Cqs), cardName(trick.FilterGE(cards.King).Highest()))
 		}
 		// play high spade not queen
 		nonQueenSpades := legalPlays.Filter(func(c cards.Card) bool { return c != cards.Cqs })
 		if len(nonQueenSpades) > 0 {
-			return nonQueenSpades.Highest()
+			return explain(nonQueenSpades.Highest(), "keep-queen",
+				"Holding the %s until someone plays the %s or %s",
+				cardName(cards.Cqs), cardName(cards.Cks), cardName(cards.Cas))
 		}
 		// else play queen
-		return cards.Cqs
+		return explain(cards.Cqs, "only-queen", "The %s is your only spade", cardName(cards.Cqs))
 	}
 	// so we don't have qs,
 	// if we're the last card in the trick, play high spade
 	if len(trick) == 3 {
-		return legalPlays.Highest()
+		return explain(legalPlays.Highest(), "last-high-spade",
+			"You're last and the %s isn't in the trick, so getting rid of your highest spade", cardName(cards.Cqs))
 	}
 	// else play high spade under qs
 	spadesUnderQueen := legalPlays.FilterLE(cards.Jack)
 	if len(spadesUnderQueen) > 0 {
-		return spadesUnderQueen.Highest()
+		return explain(spadesUnderQueen.Highest(), "spade-under-queen",
+			"Playing your highest spade below the %s so it can't be dropped on you", cardName(cards.Cqs))
 	}
 	// else play high spade
-	return legalPlays.Highest()
+	return explain(legalPlays.Highest(), "high-spade", "All your spades are above the %s", cardName(cards.Cqs))
 }
 
-func followSuit(gs client.GameState) cards.Card {
+func followSuit(gs client.GameState) Explanation {
 	legalPlays := gs.LegalPlays
 	trick := gs.CurrentTrick
 	leadSuit := trick[0].Suit
@@ -116,64 +143,73 @@ func followSuit(gs client.GameState) cards.Card {
 	if len(trick) == 3 {
 		// If there are just 0 or 1 hearts in the trick, just take it.
 		if trick.CountSuit(cards.Hearts) <= 1 && !trick.ContainsCard(cards.Cqs) {
-			return legalPlays.Highest()
+			return explain(legalPlays.Highest(), "take-cheap-trick",
+				"The trick has at most one heart and no %s, so taking it with your highest %s", cardName(cards.Cqs), leadSuit.Name())
 		}
 		// If we have to take it anyway, go high.
 		if best.Value > leadingCard.Value {
-			return legalPlays.Highest()
+			return explain(legalPlays.Highest(), "forced-take", "You have to take the trick, so using your highest %s", leadSuit.Name())
 		}
 		// Otherwise, dump our highest card without taking the trick.
-		return best
+		return explain(best, "duck-last", "Playing your highest %s that doesn't beat the %s", leadSuit.Name(), cardName(leadingCard))
 	}
 	// If hearts are led, try not to take it.
 	if leadSuit == cards.Hearts {
-		return best
+		return explain(best, "duck-hearts", "Ducking under the %s so you don't take the hearts", cardName(leadingCard))
 	}
 	// If this is the second-to-last card and the queen might be dumped last and we'd have to
 	// take it anyway, might as well go high.
 	if len(trick) == 2 && leadSuit != cards.Spades &&
 		qsNotYetPlayed(gs) && !trick.ContainsCard(cards.Cqs) &&
 		best.Value > leadingCard.Value {
-		return legalPlays.Highest()
+		return explain(legalPlays.Highest(), "forced-take",
+			"You can't get under the %s, so using your highest %s", cardName(leadingCard), leadSuit.Name())
 	}
 	// If qs is available ...
 	if qsNotYetPlayed(gs) {
 		// ... and if this is the first trick of the suit, play high
 		if numTricksOfSuit(gs, leadSuit) == 0 {
-			return legalPlays.Highest()
+			return explain(legalPlays.Highest(), "first-round-high",
+				"Playing high on the first round of %s, before anyone is likely to be void", leadSuit.Name())
 		}
 		// else try not to take trick.
-		return best
+		return explain(best, "duck-queen-out",
+			"Trying not to take the trick while the %s could still be dropped on it", cardName(cards.Cqs))
 	}
 	// qs is not available
 	// If this is one of the first two tricks of suit, play high
 	if numTricksOfSuit(gs, leadSuit) <= 1 {
-		return legalPlays.Highest()
+		return explain(legalPlays.Highest(), "early-round-high",
+			"Playing high while everyone probably still has %s", leadSuit.Name())
 	}
 	// else try not to take the trick.
-	return best
+	return explain(best, "duck", "Trying not to take the trick")
 }
 
-func chooseDumpCard(gs client.GameState) cards.Card {
+func chooseDumpCard(gs client.GameState) Explanation {
 	legalPlays := gs.LegalPlays
+	void := gs.CurrentTrick[0].Suit.Name()
 
 	// We don't have lead suit
 	// If qs hasn't been played, play a high spade
 	if qsNotYetPlayed(gs) {
 		if legalPlays.ContainsCard(cards.Cqs) {
-			return cards.Cqs
+			return explain(cards.Cqs, "dump-queen", "Dumping the %s because you're void in %s", cardName(cards.Cqs), void)
 		}
 		// if we don't have enough low spades, dump a high one.
 		spades := legalPlays.FilterBySuit(cards.Spades)
 		if len(spades.FilterLE(cards.Jack)) <= 3 &&
 			len(spades.FilterGE(cards.King)) > 0 {
-			return spades.Highest()
+			return explain(spades.Highest(), "dump-high-spade",
+				"Dumping the %s because you're void in %s and have too few low spades to keep it safe from the %s",
+				cardName(spades.Highest()), void, cardName(cards.Cqs))
 		}
 	}
 	// If we have hearts over 7, play highest
 	highHearts := legalPlays.FilterBySuit(cards.Hearts).FilterGE(cards.Eight)
 	if len(highHearts) > 0 {
-		return highHearts.Highest()
+		return explain(highHearts.Highest(), "dump-heart",
+			"Dumping the %s because you're void in %s", cardName(highHearts.Highest()), void)
 	}
 	// Don't dump a spade if we have the queen (unless we have to).
 	hand := gs.Players[0].Cards // We might have the queen even if we can't play it.
@@ -188,7 +224,9 @@ func chooseDumpCard(gs client.GameState) cards.Card {
 	suitWithHighestLowCard := cards.GetExtremeCards(playsBySuit, func(c1, c2 cards.Cards) bool {
 		return c1.Lowest().Value > c2.Lowest().Value
 	})
-	return suitWithHighestLowCard.Highest()
+	c := suitWithHighestLowCard.Highest()
+	return explain(c, "dump-weak-suit",
+		"Dumping the %s because you're void in %s and %s is your weakest suit", cardName(c), void, c.Suit.Name())
 }
 
 func numTricksOfSuit(gs client.GameState, suit cards.Suit) int {
@@ -217,3 +255,12 @@ func anyPlayedCard(gs client.GameState, cond func(cards.Card) bool) bool {
 func qsNotYetPlayed(gs client.GameState) bool {
 	return !anyPlayedCard(gs, func(c cards.Card) bool { return c == cards.Cqs })
 }
+
+func explain(c cards.Card, rule string, format string, args ...any) Explanation {
+	return Explanation{Card: c, Rule: rule, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Renders a card for an explanation.
+func cardName(c cards.Card) string {
+	return cards.DisplayNotation.Card(c)
+}
@@ -0,0 +1,57 @@
+package player
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+func TestExplainBasicStrategyCard(t *testing.T) {
+	tests := []struct {
+		name       string
+		hand       cards.Cards
+		trick      cards.Cards
+		wantCard   cards.Card
+		wantRule   string
+		wantReason string
+	}{
+		{
+			name:       "first lead",
+			hand:       cards.Cards{cards.C2c},
+			wantCard:   cards.C2c,
+			wantRule:   "lead-2c",
+			wantReason: "first trick",
+		},
+		{
+			name:       "lead away from high spades",
+			hand:       cards.Cards{cards.Cks, cards.C5d, cards.C9h},
+			wantCard:   cards.C5d,
+			wantRule:   "lead-low-nonspade",
+			wantReason: "lowest non-spade",
+		},
+		{
+			name:       "void in the lead suit",
+			hand:       cards.Cards{cards.Cqs, cards.C3c, cards.C9h},
+			trick:      cards.Cards{cards.C5d},
+			wantCard:   cards.Cqs,
+			wantRule:   "dump-queen",
+			wantReason: "void in diamonds",
+		},
+		{
+			name:       "hearts led",
+			hand:       cards.Cards{cards.C3h, cards.C9h, cards.Ckh},
+			trick:      cards.Cards{cards.C8h},
+			wantCard:   cards.C3h,
+			wantRule:   "duck-hearts",
+			wantReason: "don't take the hearts",
+		},
+	}
+	for _, tc := range tests {
+		got := ExplainBasicStrategyCard(moonGameState(tc.hand, tc.trick, nil))
+		if got.Card != tc.wantCard || got.Rule != tc.wantRule || !strings.Contains(got.Reason, tc.wantReason) {
+			t.Errorf("%s: got %s %s %q, want %s %s containing %q",
+				tc.name, got.Card, got.Rule, got.Reason, tc.wantCard, tc.wantRule, tc.wantReason)
+		}
+	}
+}
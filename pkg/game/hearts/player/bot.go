@@ -0,0 +1,351 @@
+package player
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+// BotStrategy runs a bot as a child process and asks it which card to play,
+// so that bots can be written in any language.
+//
+// The bot reads JSON messages from stdin and writes JSON messages to stdout, one per line.
+// Anything it writes to stderr is passed through to our stderr.
+//
+// Messages to the bot all have a "type":
+//
+//	{"type":"hello","protocol":1}
+//	    Sent when the bot starts. The bot replies {"type":"hello","name":"..."}.
+//	{"type":"game_started","state":STATE}
+//	{"type":"trick_completed","trick":["2c","Ac","5c","Tc"],"winning_card":"Ac","winner_id":"..."}
+//	{"type":"your_turn","state":STATE}
+//	    The bot replies {"type":"play","card":"Qs"} with one of the state's legal_plays.
+//	{"type":"game_finished","state":STATE}
+//	{"type":"quit"}
+//	    The bot should exit.
+//
+// Only hello and your_turn expect a reply. The bot may also write {"type":"log","message":"..."}
+// at any time, which is logged. A STATE is this player's view of the game:
+//
+//	{"id":"...","phase":"Playing","current_trick":["3d"],"legal_plays":["5d","Jd"],
+//	 "players":[{"id":"...","name":"...","cards":["5d","Jd","2h"],"num_cards":3,
+//	             "tricks":[["2c","Ac","5c","Tc"]],"trick_score":0,"hand_score":0,"is_next":true}, ...]}
+//
+// players starts with the bot's own player and follows in turn order; only the bot's own
+// cards are shown. Cards are a value (2-9, T, J, Q, K, A) followed by a suit (c, d, h, s).
+//
+// Every your_turn includes the whole state, so a bot that is restarted mid-game
+// can carry on. If the bot doesn't reply in time, replies with a card it can't play,
+// or exits, we play this move with basic strategy and restart the bot.
+
+const botProtocolVersion = 1
+
+type BotConfig struct {
+	Command     []string      // Program to run and its arguments.
+	MoveTimeout time.Duration // Time allowed to reply to each message.
+	MaxRestarts int           // After this many restarts, play the rest of the game with basic strategy.
+}
+
+var DefaultBotConfig = BotConfig{
+	MoveTimeout: 5 * time.Second,
+	MaxRestarts: 3,
+}
+
+func NewBotStrategy(config BotConfig) PlayerStrategy {
+	return &botStrategy{config: config}
+}
+
+type botStrategy struct {
+	config   BotConfig
+	process  *botProcess
+	restarts int
+	disabled bool
+}
+
+// Messages to the bot.
+type botMessage struct {
+	Type        string        `json:"type"`
+	Protocol    int           `json:"protocol,omitempty"`
+	State       *botGameState `json:"state,omitempty"`
+	Trick       []string      `json:"trick,omitempty"`
+	WinningCard string        `json:"winning_card,omitempty"`
+	WinnerId    string        `json:"winner_id,omitempty"`
+}
+
+// Messages from the bot.
+type botReply struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Card    string `json:"card"`
+	Message string `json:"message"`
+}
+
+type botGameState struct {
+	Id           string           `json:"id"`
+	Phase        string           `json:"phase"`
+	Players      []botPlayerState `json:"players"`
+	CurrentTrick []string         `json:"current_trick"`
+	LegalPlays   []string         `json:"legal_plays"`
+}
+
+type botPlayerState struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Cards      []string   `json:"cards"`
+	NumCards   int        `json:"num_cards"`
+	Tricks     [][]string `json:"tricks"`
+	TrickScore int        `json:"trick_score"`
+	HandScore  int        `json:"hand_score"`
+	IsNext     bool       `json:"is_next"`
+}
+
+func newBotGameState(gs client.GameState) *botGameState {
+	s := &botGameState{
+		Id:           gs.Id,
+		Phase:        gs.Phase.String(),
+		CurrentTrick: gs.CurrentTrick.Strings(),
+		LegalPlays:   gs.LegalPlays.Strings(),
+	}
+	for _, p := range gs.Players {
+		ps := botPlayerState{
+			Id:         p.Id,
+			Name:       p.Name,
+			Cards:      p.Cards.Strings(),
+			NumCards:   p.NumCards,
+			Tricks:     [][]string{},
+			TrickScore: p.TrickScore,
+			HandScore:  p.HandScore,
+			IsNext:     p.IsNext,
+		}
+		for _, t := range p.Tricks {
+			ps.Tricks = append(ps.Tricks, t.Strings())
+		}
+		s.Players = append(s.Players, ps)
+	}
+	return s
+}
+
+func (s *botStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	if s.disabled {
+		return ChooseBasicStrategyCard(gs)
+	}
+	card, err := s.requestPlay(gs)
+	if err != nil {
+		log.Printf("Bot %s failed, playing basic strategy this turn: %v", s.config.Command[0], err)
+		s.restart()
+		return ChooseBasicStrategyCard(gs)
+	}
+	if !gs.LegalPlays.ContainsCard(card) {
+		log.Printf("Bot %s chose %s, which is not one of %s; playing basic strategy this turn", s.config.Command[0], card, gs.LegalPlays)
+		s.restart()
+		return ChooseBasicStrategyCard(gs)
+	}
+	return card
+}
+
+func (s *botStrategy) requestPlay(gs client.GameState) (cards.Card, error) {
+	if err := s.start(); err != nil {
+		return cards.Card{}, err
+	}
+	if err := s.process.send(botMessage{Type: "your_turn", State: newBotGameState(gs)}); err != nil {
+		return cards.Card{}, err
+	}
+	reply, err := s.process.receive(s.config.MoveTimeout)
+	if err != nil {
+		return cards.Card{}, err
+	}
+	if reply.Type != "play" {
+		return cards.Card{}, fmt.Errorf("expected play, got %q", reply.Type)
+	}
+	return cards.ParseCard(reply.Card)
+}
+
+// Starts the bot process if it isn't running.
+func (s *botStrategy) start() error {
+	if s.process != nil {
+		return nil
+	}
+	p, err := startBotProcess(s.config)
+	if err != nil {
+		return err
+	}
+	s.process = p
+	return nil
+}
+
+// Stops the bot process so that it's started again on the next turn,
+// unless it has already been restarted too many times.
+func (s *botStrategy) restart() {
+	s.Close()
+	s.restarts++
+	if s.restarts > s.config.MaxRestarts {
+		log.Printf("Bot %s restarted too many times, playing basic strategy for the rest of the game", s.config.Command[0])
+		s.disabled = true
+	}
+}
+
+// Sends a message that needs no reply to a running bot.
+func (s *botStrategy) notify(m botMessage) {
+	if s.process == nil {
+		return
+	}
+	if err := s.process.send(m); err != nil {
+		log.Printf("Bot %s failed: %v", s.config.Command[0], err)
+		s.restart()
+	}
+}
+
+func (s *botStrategy) GameStarted(gs client.GameState) {
+	if s.disabled {
+		return
+	}
+	if err := s.start(); err != nil {
+		log.Printf("Couldn't start bot %s: %v", s.config.Command[0], err)
+		s.restart()
+		return
+	}
+	s.notify(botMessage{Type: "game_started", State: newBotGameState(gs)})
+}
+
+func (s *botStrategy) TrickCompleted(trick cards.Cards, winningCard cards.Card, winnerId string) {
+	s.notify(botMessage{
+		Type:        "trick_completed",
+		Trick:       trick.Strings(),
+		WinningCard: winningCard.String(),
+		WinnerId:    winnerId,
+	})
+}
+
+func (s *botStrategy) GameFinished(gs client.GameState) {
+	s.notify(botMessage{Type: "game_finished", State: newBotGameState(gs)})
+}
+
+// Stops the bot process.
+func (s *botStrategy) Close() error {
+	if s.process == nil {
+		return nil
+	}
+	s.process.stop()
+	s.process = nil
+	return nil
+}
+
+// A running bot.
+type botProcess struct {
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	replies chan botReply // Closed when the bot's stdout closes.
+	done    chan struct{} // Closed when we stop the bot.
+}
+
+func startBotProcess(config BotConfig) (*botProcess, error) {
+	if len(config.Command) == 0 {
+		return nil, fmt.Errorf("no bot command")
+	}
+	cmd := exec.Command(config.Command[0], config.Command[1:]...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("couldn't start bot: %w", err)
+	}
+	p := &botProcess{cmd: cmd, stdin: stdin, replies: make(chan botReply), done: make(chan struct{})}
+	go p.readReplies(stdout)
+
+	if err := p.send(botMessage{Type: "hello", Protocol: botProtocolVersion}); err != nil {
+		p.stop()
+		return nil, err
+	}
+	reply, err := p.receive(config.MoveTimeout)
+	if err == nil && reply.Type != "hello" {
+		err = fmt.Errorf("expected hello, got %q", reply.Type)
+	}
+	if err != nil {
+		p.stop()
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *botProcess) readReplies(stdout io.Reader) {
+	defer close(p.replies)
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var reply botReply
+		if err := json.Unmarshal([]byte(line), &reply); err != nil {
+			log.Printf("Bot %s wrote invalid message %q: %v", p.cmd.Path, line, err)
+			continue
+		}
+		if reply.Type == "log" {
+			log.Printf("Bot %s: %s", p.cmd.Path, reply.Message)
+			continue
+		}
+		select {
+		case p.replies <- reply:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *botProcess) send(m botMessage) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if _, err := p.stdin.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("couldn't send %s: %w", m.Type, err)
+	}
+	return nil
+}
+
+func (p *botProcess) receive(timeout time.Duration) (botReply, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case reply, ok := <-p.replies:
+		if !ok {
+			return botReply{}, fmt.Errorf("bot exited")
+		}
+		return reply, nil
+	case <-timer.C:
+		return botReply{}, fmt.Errorf("no reply within %s", timeout)
+	}
+}
+
+// Asks the bot to quit, and kills it if it doesn't.
+func (p *botProcess) stop() {
+	close(p.done)
+	p.send(botMessage{Type: "quit"})
+	p.stdin.Close()
+	done := make(chan struct{})
+	go func() {
+		p.cmd.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		p.cmd.Process.Kill()
+		<-done
+	}
+}
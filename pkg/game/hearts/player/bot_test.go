@@ -0,0 +1,125 @@
+package player
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// Runs this test binary as a bot that behaves as named by the last argument:
+// "lowest" plays the lowest legal card, "crash" exits when asked to play,
+// "slow" never replies to your_turn and "illegal" always plays the Qs.
+func TestBotHelperProcess(t *testing.T) {
+	if os.Getenv("HEARTS_TEST_BOT") != "1" {
+		return
+	}
+	behavior := os.Args[len(os.Args)-1]
+	in := bufio.NewScanner(os.Stdin)
+	for in.Scan() {
+		var m struct {
+			Type  string
+			State struct {
+				LegalPlays []string `json:"legal_plays"`
+			}
+		}
+		json.Unmarshal(in.Bytes(), &m)
+		switch m.Type {
+		case "hello":
+			fmt.Println(`{"type":"log","message":"starting"}`)
+			fmt.Println(`{"type":"hello","name":"test bot"}`)
+		case "your_turn":
+			switch behavior {
+			case "crash":
+				os.Exit(1)
+			case "slow":
+				time.Sleep(time.Minute)
+			case "illegal":
+				fmt.Println(`{"type":"play","card":"Qs"}`)
+			default:
+				var legalPlays cards.Cards
+				for _, c := range m.State.LegalPlays {
+					legalPlays = append(legalPlays, cards.ParseCardOrDie(c))
+				}
+				fmt.Printf("{\"type\":\"play\",\"card\":%q}\n", legalPlays.Lowest())
+			}
+		case "quit":
+			os.Exit(0)
+		}
+	}
+	os.Exit(0)
+}
+
+func newTestBot(behavior string) *botStrategy {
+	return NewBotStrategy(BotConfig{
+		Command:     []string{os.Args[0], "-test.run=TestBotHelperProcess", "--", behavior},
+		MoveTimeout: 2 * time.Second,
+		MaxRestarts: 1,
+	}).(*botStrategy)
+}
+
+func TestBotStrategy(t *testing.T) {
+	t.Setenv("HEARTS_TEST_BOT", "1")
+	tests := []struct {
+		behavior     string
+		timeout      time.Duration
+		wantDisabled bool
+	}{
+		{behavior: "lowest"},
+		{behavior: "illegal", wantDisabled: true},
+		{behavior: "crash", wantDisabled: true},
+		{behavior: "slow", timeout: 100 * time.Millisecond, wantDisabled: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.behavior, func(t *testing.T) {
+			bot := newTestBot(tc.behavior)
+			if tc.timeout > 0 {
+				bot.config.MoveTimeout = tc.timeout
+			}
+			defer bot.Close()
+			pos := &hearts.Position{FirstTrick: true}
+			for i, h := range cards.Deal(4) {
+				pos.Hands[i] = h
+				if h.ContainsCard(cards.C2c) {
+					pos.Leader = i
+				}
+			}
+			var tricks [4][]cards.Cards
+			bot.GameStarted(GameStateForSeat(pos, tricks, 0))
+			for !pos.IsOver() {
+				seat := pos.NextSeat()
+				gs := GameStateForSeat(pos, tricks, seat)
+				var card cards.Card
+				if seat == 0 {
+					card = bot.ChooseCardToPlay(gs)
+					if !gs.LegalPlays.ContainsCard(card) {
+						t.Fatalf("bot played %s, want one of %s", card, gs.LegalPlays)
+					}
+					if tc.behavior == "lowest" && card != gs.LegalPlays.Lowest() {
+						t.Errorf("bot played %s, want %s", card, gs.LegalPlays.Lowest())
+					}
+				} else {
+					card = ChooseBasicStrategyCard(gs)
+				}
+				trick := append(pos.Trick.Copy(), card)
+				pos.Play(card)
+				if len(trick) == 4 {
+					tricks[pos.Leader] = append(tricks[pos.Leader], trick)
+					bot.TrickCompleted(trick, trick.LeadingCardOfTrick(), GameStateForSeat(pos, tricks, 0).Players[0].Id)
+				}
+			}
+			gs := GameStateForSeat(pos, tricks, 0)
+			gs.Phase = client.Completed
+			bot.GameFinished(gs)
+			if bot.disabled != tc.wantDisabled {
+				t.Errorf("disabled=%t, want %t", bot.disabled, tc.wantDisabled)
+			}
+		})
+	}
+}
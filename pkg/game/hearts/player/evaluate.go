@@ -0,0 +1,169 @@
+package player
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// EvaluatePlays estimates what each legal play will cost, by dealing the hidden cards
+// many times and playing out the rest of the hand after each play.
+
+type EvaluationConfig struct {
+	Samples    int           // Number of deals to sample.
+	TimeBudget time.Duration // Stop sampling after this long, even if fewer samples are done. 0 means no limit.
+}
+
+var DefaultEvaluationConfig = EvaluationConfig{
+	Samples:    200,
+	TimeBudget: 500 * time.Millisecond,
+}
+
+// A play is flagged with a risk when it happens in at least this fraction of samples.
+const riskThreshold = 0.2
+
+// The estimated outcome of one legal play.
+type PlayEvaluation struct {
+	Card cards.Card
+	// Average points taken from now to the end of the hand, after moon shots are scored.
+	ExpectedPoints float64
+	// Bad things that often follow this play, such as "may take Q♠".
+	Risks []string
+}
+
+// Returns an evaluation of each of the legal plays, best first,
+// or nil if it isn't this player's turn.
+func EvaluatePlays(gs client.GameState, config EvaluationConfig) []PlayEvaluation {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return evaluatePlays(gs, newKnowledge(gs), config, rng)
+}
+
+// What happened after one play, summed over samples.
+type playOutcomes struct {
+	points      int
+	takesQueen  int
+	takesPoints int // Won the current trick with points in it.
+	moons       [4]int
+}
+
+func evaluatePlays(gs client.GameState, k knowledge, config EvaluationConfig, rng *rand.Rand) []PlayEvaluation {
+	legalPlays := gs.LegalPlays
+	if len(legalPlays) == 0 {
+		return nil
+	}
+	outcomes := make([]playOutcomes, len(legalPlays))
+	deadline := time.Now().Add(config.TimeBudget)
+	numSamples := 0
+	for numSamples < config.Samples {
+		if config.TimeBudget > 0 && numSamples > 0 && time.Now().After(deadline) {
+			break
+		}
+		hands := k.sampleHands(gs, rng)
+		if hands == nil {
+			break
+		}
+		pos := positionFromGameState(gs, *hands)
+		for i, c := range legalPlays {
+			outcomes[i].add(rollOut(pos.Clone(), c))
+		}
+		numSamples++
+	}
+	evals := make([]PlayEvaluation, len(legalPlays))
+	for i, c := range legalPlays {
+		evals[i] = PlayEvaluation{Card: c}
+		if numSamples == 0 {
+			continue
+		}
+		o := outcomes[i]
+		n := float64(numSamples)
+		evals[i].ExpectedPoints = float64(o.points)/n - float64(gs.Players[0].TrickScore)
+		if float64(o.takesQueen)/n >= riskThreshold {
+			evals[i].Risks = append(evals[i].Risks, fmt.Sprintf("may take %s", cardName(cards.Cqs)))
+		}
+		if float64(o.takesPoints)/n >= riskThreshold {
+			evals[i].Risks = append(evals[i].Risks, "may take points this trick")
+		}
+		for seat := 1; seat < 4; seat++ {
+			if float64(o.moons[seat])/n >= riskThreshold {
+				evals[i].Risks = append(evals[i].Risks, fmt.Sprintf("may let %s shoot the moon", gs.Players[seat].Name))
+			}
+		}
+	}
+	sort.SliceStable(evals, func(i, j int) bool { return evals[i].ExpectedPoints < evals[j].ExpectedPoints })
+	return evals
+}
+
+func (o *playOutcomes) add(r rolloutResult) {
+	o.points += r.score
+	if r.tookQueen {
+		o.takesQueen++
+	}
+	if r.tookPoints {
+		o.takesPoints++
+	}
+	if r.moon >= 0 {
+		o.moons[r.moon]++
+	}
+}
+
+// What happened to seat 0 in one rollout.
+type rolloutResult struct {
+	score      int  // Seat 0's score for the hand.
+	tookQueen  bool // Seat 0 took the Qs.
+	tookPoints bool // Seat 0 won the trick in progress, and it had points.
+	moon       int  // Seat that shot the moon, or -1.
+}
+
+// Plays c for seat 0, then plays out the rest of the hand with RolloutCard for every seat.
+func rollOut(p *hearts.Position, c cards.Card) rolloutResult {
+	r := rolloutResult{moon: -1}
+	firstTrick := true
+	for {
+		completes := len(p.Trick) == 3
+		trick := append(p.Trick.Copy(), c)
+		p.Play(c)
+		// The trick's winner leads the next one.
+		if completes && p.Leader == 0 {
+			if trick.ContainsCard(cards.Cqs) {
+				r.tookQueen = true
+			}
+			if firstTrick && trickScore(trick) > 0 {
+				r.tookPoints = true
+			}
+		}
+		if completes {
+			firstTrick = false
+		}
+		if p.IsOver() {
+			break
+		}
+		c = p.RolloutCard()
+	}
+	scores := p.HandScores()
+	r.score = scores[0]
+	for seat, points := range p.Points {
+		if points == 26 {
+			r.moon = seat
+		}
+	}
+	return r
+}
+
+// Renders evaluations one per line, such as "Q♠  8.3 points  may take points this trick".
+func FormatPlayEvaluations(evals []PlayEvaluation, n cards.Notation) string {
+	var sb strings.Builder
+	for _, e := range evals {
+		fmt.Fprintf(&sb, "  %s %5.1f points", n.Card(e.Card), e.ExpectedPoints)
+		if len(e.Risks) > 0 {
+			fmt.Fprintf(&sb, "  %s", strings.Join(e.Risks, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,59 @@
+package player
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+func TestEvaluatePlays(t *testing.T) {
+	// Last to play to a trick of three hearts.
+	hand := cards.Cards{cards.C2h, cards.Cah, cards.C3c, cards.C4c, cards.C5c, cards.C6c, cards.C7c,
+		cards.C2d, cards.C3d, cards.C4d, cards.C5d, cards.C6d, cards.C7d}
+	gs := moonGameState(hand, cards.Cards{cards.C8h, cards.C9h, cards.Cth}, nil)
+	gs.LegalPlays = cards.Cards{cards.C2h, cards.Cah}
+	for i := range gs.Players {
+		gs.Players[i].NumCards = 12
+	}
+	gs.Players[0].NumCards = 13
+	evals := evaluatePlays(gs, newKnowledge(gs), EvaluationConfig{Samples: 20}, rand.New(rand.NewSource(1)))
+	if len(evals) != 2 {
+		t.Fatalf("got %d evaluations, want 2", len(evals))
+	}
+	if evals[0].Card != cards.C2h || len(evals[0].Risks) != 0 {
+		t.Errorf("best play: got %s %v, want 2h with no risks", evals[0].Card, evals[0].Risks)
+	}
+	if evals[1].Card != cards.Cah || evals[1].ExpectedPoints < 4 {
+		t.Errorf("worst play: got %s %.1f points, want Ah with at least 4", evals[1].Card, evals[1].ExpectedPoints)
+	}
+	if !containsString(evals[1].Risks, "may take points this trick") {
+		t.Errorf("Ah risks: got %v, want points this trick", evals[1].Risks)
+	}
+}
+
+func TestGameStateForNextPlayer(t *testing.T) {
+	gs := moonGameState(cards.Cards{cards.C2c}, nil, nil)
+	gs.Players[0].IsNext = false
+	gs.Players[2].IsNext = true
+	gs.Players[2].Cards = cards.Cards{cards.C3c}
+	view, ok := GameStateForNextPlayer(gs)
+	if !ok {
+		t.Fatal("GameStateForNextPlayer() returned false")
+	}
+	if view.Players[0].Name != "North" || view.Players[2].Name != "Me" {
+		t.Errorf("got players %s, %s, %s, %s, want North first", view.Players[0].Name, view.Players[1].Name, view.Players[2].Name, view.Players[3].Name)
+	}
+	if len(view.Players[2].Cards) != 0 {
+		t.Errorf("other players' cards are visible: %s", view.Players[2].Cards)
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package player
+
+import (
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"golang.org/x/exp/slices"
+)
+
+// HistoryStrategy is implemented by strategies that choose cards from everything that has
+// happened in the hand, in order, rather than only the GameState snapshot: who led each trick,
+// who played each card, who won, and when each player first failed to follow suit.
+type HistoryStrategy interface {
+	ChooseCardWithHistory(client.GameState, *HandHistory) cards.Card
+}
+
+// Returns s as a HistoryStrategy. Strategies that don't implement HistoryStrategy
+// are adapted to ignore the history and choose from the GameState alone.
+func AsHistoryStrategy(s PlayerStrategy) HistoryStrategy {
+	if hs, ok := s.(HistoryStrategy); ok {
+		return hs
+	}
+	return snapshotStrategy{s}
+}
+
+type snapshotStrategy struct {
+	PlayerStrategy
+}
+
+func (s snapshotStrategy) ChooseCardWithHistory(gs client.GameState, h *HandHistory) cards.Card {
+	return s.ChooseCardToPlay(gs)
+}
+
+// A card and the player who played it.
+type Play struct {
+	PlayerId string
+	Card     cards.Card
+}
+
+type TrickHistory struct {
+	Plays    []Play // In the order played; Plays[0] is the lead.
+	WinnerId string // Empty until the trick is complete.
+}
+
+func (t TrickHistory) LeaderId() string {
+	if len(t.Plays) == 0 {
+		return ""
+	}
+	return t.Plays[0].PlayerId
+}
+
+func (t TrickHistory) IsComplete() bool {
+	return t.WinnerId != ""
+}
+
+// Every card played so far in a hand, in order.
+type HandHistory struct {
+	Tricks []TrickHistory // The last one is the current trick if it isn't complete.
+}
+
+// Forgets everything, for the start of a new hand.
+func (h *HandHistory) Reset() {
+	h.Tricks = nil
+}
+
+// Records that playerId played c.
+func (h *HandHistory) AddPlay(playerId string, c cards.Card) {
+	if len(h.Tricks) == 0 || h.Tricks[len(h.Tricks)-1].IsComplete() {
+		h.Tricks = append(h.Tricks, TrickHistory{})
+	}
+	t := &h.Tricks[len(h.Tricks)-1]
+	t.Plays = append(t.Plays, Play{PlayerId: playerId, Card: c})
+}
+
+// Records that winnerId won the current trick.
+func (h *HandHistory) CompleteTrick(winnerId string) {
+	if len(h.Tricks) == 0 {
+		return
+	}
+	h.Tricks[len(h.Tricks)-1].WinnerId = winnerId
+}
+
+// Returns the trick being played, or nil if the next card starts a new trick.
+func (h *HandHistory) CurrentTrick() *TrickHistory {
+	if len(h.Tricks) == 0 || h.Tricks[len(h.Tricks)-1].IsComplete() {
+		return nil
+	}
+	return &h.Tricks[len(h.Tricks)-1]
+}
+
+// Returns every play of the hand so far, in order.
+func (h *HandHistory) Plays() []Play {
+	var plays []Play
+	for _, t := range h.Tricks {
+		plays = append(plays, t.Plays...)
+	}
+	return plays
+}
+
+// Returns the suits playerId has shown out of, by failing to follow them.
+func (h *HandHistory) VoidSuits(playerId string) []cards.Suit {
+	var voids []cards.Suit
+	for _, t := range h.Tricks {
+		if len(t.Plays) == 0 {
+			continue
+		}
+		led := t.Plays[0].Card.Suit
+		for _, p := range t.Plays[1:] {
+			if p.PlayerId == playerId && p.Card.Suit != led && !slices.Contains(voids, led) {
+				voids = append(voids, led)
+			}
+		}
+	}
+	return voids
+}
@@ -0,0 +1,66 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+func TestHandHistory(t *testing.T) {
+	var h HandHistory
+	if h.CurrentTrick() != nil {
+		t.Fatalf("empty history has a current trick")
+	}
+	for i, c := range (cards.Cards{cards.C2c, cards.Cac, cards.C5d, cards.Ctc}) {
+		h.AddPlay(string(rune('a'+i)), c)
+	}
+	h.CompleteTrick("b")
+	h.AddPlay("b", cards.C3h)
+	h.AddPlay("c", cards.C4h)
+
+	if len(h.Tricks) != 2 {
+		t.Fatalf("got %d tricks, want 2", len(h.Tricks))
+	}
+	if got := h.Tricks[0].LeaderId(); got != "a" {
+		t.Errorf("first trick led by %s, want a", got)
+	}
+	current := h.CurrentTrick()
+	if current == nil || current.LeaderId() != "b" || len(current.Plays) != 2 {
+		t.Errorf("current trick is %v, want two cards led by b", current)
+	}
+	if got := len(h.Plays()); got != 6 {
+		t.Errorf("got %d plays, want 6", got)
+	}
+	if got := h.VoidSuits("c"); len(got) != 1 || got[0] != cards.Clubs {
+		t.Errorf("c is void in %v, want clubs", got)
+	}
+	if got := h.VoidSuits("d"); len(got) != 0 {
+		t.Errorf("d is void in %v, want none", got)
+	}
+	h.Reset()
+	if len(h.Tricks) != 0 {
+		t.Errorf("Reset left %d tricks", len(h.Tricks))
+	}
+}
+
+type historyTestStrategy struct{}
+
+func (historyTestStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	return gs.LegalPlays[0]
+}
+func (historyTestStrategy) ChooseCardWithHistory(gs client.GameState, h *HandHistory) cards.Card {
+	return gs.LegalPlays[len(gs.LegalPlays)-1]
+}
+
+func TestAsHistoryStrategy(t *testing.T) {
+	gs := client.GameState{LegalPlays: cards.Cards{cards.C2c, cards.C3c}}
+	// Embedding hides ChooseCardWithHistory, so only ChooseCardToPlay is left.
+	snapshotOnly := struct{ PlayerStrategy }{historyTestStrategy{}}
+	if got := AsHistoryStrategy(snapshotOnly).ChooseCardWithHistory(gs, &HandHistory{}); got != cards.C2c {
+		t.Errorf("adapted strategy chose %s, want %s", got, cards.C2c)
+	}
+	if got := AsHistoryStrategy(historyTestStrategy{}).ChooseCardWithHistory(gs, &HandHistory{}); got != cards.C3c {
+		t.Errorf("history strategy wasn't used directly, chose %s", got)
+	}
+}
@@ -0,0 +1,291 @@
+package player
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// Seats are indexes into GameState.Players, which start with this player and follow in turn order.
+
+// A completed trick with the seat that played each card.
+type seatedTrick struct {
+	leader int
+	cards  cards.Cards // in order of play
+}
+
+func (t seatedTrick) seat(i int) int {
+	return (t.leader + i) % 4
+}
+
+// knowledge is what this player can work out about the other players' hidden cards.
+type knowledge struct {
+	unseen cards.Cards // Cards held by other players.
+	voids  [4][4]bool  // [seat][suit] is true if the seat has shown out of the suit.
+}
+
+// Works out what can be known from a GameState alone.
+func newKnowledge(gs client.GameState) knowledge {
+	var k knowledge
+	tricks, _ := reconstructTricks(gs)
+	for _, t := range tricks {
+		k.addVoids(t.leader, t.cards)
+	}
+	k.addVoids(currentTrickLeader(gs), gs.CurrentTrick)
+	k.unseen = unseenCards(gs)
+	return k
+}
+
+// Records that anyone who didn't follow the suit led is void in it.
+func (k *knowledge) addVoids(leader int, trick cards.Cards) {
+	if len(trick) == 0 {
+		return
+	}
+	leadSuit := trick[0].Suit
+	for i, c := range trick {
+		if c.Suit != leadSuit {
+			k.voids[(leader+i)%4][leadSuit] = true
+		}
+	}
+}
+
+// Cards not in this player's hand and not yet played.
+func unseenCards(gs client.GameState) cards.Cards {
+	seen := playedCards(gs)
+	seen = append(seen, gs.Players[0].Cards...)
+	return cards.MakeDeck().Filter(func(c cards.Card) bool { return !seen.ContainsCard(c) })
+}
+
+// All cards played so far, including the current trick.
+func playedCards(gs client.GameState) cards.Cards {
+	played := gs.CurrentTrick.Copy()
+	for _, p := range gs.Players {
+		for _, t := range p.Tricks {
+			played = append(played, t...)
+		}
+	}
+	return played
+}
+
+// This player is next to play, so the trick was led by the player len(trick) seats back.
+func currentTrickLeader(gs client.GameState) int {
+	return (4 - len(gs.CurrentTrick)) % 4
+}
+
+// GameState lists each player's tricks, but not the order the tricks were played in or
+// who played each card. Each trick is led by the previous trick's winner, and the
+// position of the winning card tells us who led it, which is almost always enough to
+// put the tricks back in order. Returns false if no consistent order is found.
+func reconstructTricks(gs client.GameState) ([]seatedTrick, bool) {
+	numTricks := 0
+	for _, p := range gs.Players {
+		numTricks += len(p.Tricks)
+	}
+	next := make([]int, len(gs.Players)) // index of each player's next unplaced trick
+	var order []seatedTrick
+	var place func(leader int) bool
+	place = func(leader int) bool {
+		if len(order) == numTricks {
+			return numTricks == 0 || len(gs.CurrentTrick) == 0 || leader == currentTrickLeader(gs)
+		}
+		for seat, p := range gs.Players {
+			if next[seat] >= len(p.Tricks) {
+				continue
+			}
+			t := p.Tricks[next[seat]]
+			if len(t) != 4 {
+				continue
+			}
+			winningCard := t.LeadingCardOfTrick()
+			impliedLeader := seat
+			for i, c := range t {
+				if c == winningCard {
+					impliedLeader = (seat - i + 4) % 4
+				}
+			}
+			isFirst := len(order) == 0
+			if isFirst && t[0] != cards.C2c || !isFirst && impliedLeader != leader {
+				continue
+			}
+			next[seat]++
+			order = append(order, seatedTrick{leader: impliedLeader, cards: t})
+			if place(seat) {
+				return true
+			}
+			order = order[:len(order)-1]
+			next[seat]--
+		}
+		return false
+	}
+	if !place(0) {
+		return nil, false
+	}
+	return order, true
+}
+
+// Deals the unseen cards to the other players at random, consistent with the known voids.
+// Returns nil if no consistent deal was found.
+func (k knowledge) sampleHands(gs client.GameState, rng *rand.Rand) *[4]cards.Cards {
+	var hands [4]cards.Cards
+	hands[0] = gs.Players[0].Cards.Copy()
+	for attempt := 0; attempt < 20; attempt++ {
+		var need [4]int
+		for seat := 1; seat < 4; seat++ {
+			need[seat] = gs.Players[seat].NumCards
+			hands[seat] = nil
+		}
+		unseen := k.unseen.Copy()
+		rng.Shuffle(len(unseen), func(i, j int) { unseen[i], unseen[j] = unseen[j], unseen[i] })
+		// Place the most constrained cards first.
+		numHolders := func(c cards.Card) int {
+			n := 0
+			for seat := 1; seat < 4; seat++ {
+				if !k.voids[seat][c.Suit] {
+					n++
+				}
+			}
+			return n
+		}
+		sortByConstraint(unseen, numHolders)
+		ok := true
+		for _, c := range unseen {
+			total := 0
+			for seat := 1; seat < 4; seat++ {
+				if !k.voids[seat][c.Suit] {
+					total += need[seat]
+				}
+			}
+			if total == 0 {
+				ok = false
+				break
+			}
+			// Choose a seat with probability proportional to its open slots.
+			r := rng.Intn(total)
+			for seat := 1; seat < 4; seat++ {
+				if k.voids[seat][c.Suit] {
+					continue
+				}
+				if r < need[seat] {
+					hands[seat] = append(hands[seat], c)
+					need[seat]--
+					break
+				}
+				r -= need[seat]
+			}
+		}
+		if ok {
+			return &hands
+		}
+	}
+	return nil
+}
+
+func sortByConstraint(cs cards.Cards, numHolders func(cards.Card) int) {
+	// Stable insertion sort keeps the shuffled order within each group.
+	for i := 1; i < len(cs); i++ {
+		for j := i; j > 0 && numHolders(cs[j]) < numHolders(cs[j-1]); j-- {
+			cs[j], cs[j-1] = cs[j-1], cs[j]
+		}
+	}
+}
+
+// Builds a Position from this player's point of view, with the given hands for every seat.
+func positionFromGameState(gs client.GameState, hands [4]cards.Cards) *hearts.Position {
+	pos := &hearts.Position{
+		Hands:      hands,
+		Trick:      gs.CurrentTrick.Copy(),
+		Leader:     currentTrickLeader(gs),
+		FirstTrick: true,
+	}
+	for seat, p := range gs.Players {
+		pos.Points[seat] = p.TrickScore
+		if len(p.Tricks) > 0 {
+			pos.FirstTrick = false
+		}
+	}
+	pos.HeartsBroken = playedCards(gs).ContainsSuit(cards.Hearts)
+	return pos
+}
+
+// Builds the GameState that the server would show the given seat of a Position,
+// where tricks holds the tricks taken so far by each seat.
+func GameStateForSeat(pos *hearts.Position, tricks [4][]cards.Cards, seat int) client.GameState {
+	gs := client.GameState{
+		Phase:        client.Playing,
+		CurrentTrick: pos.Trick.Copy(),
+	}
+	if pos.NextSeat() == seat {
+		gs.LegalPlays = pos.LegalPlays()
+	}
+	for i := 0; i < 4; i++ {
+		s := (seat + i) % 4
+		ps := client.PlayerState{
+			Id:         strconv.Itoa(s),
+			Name:       cards.Seat(s).Name(),
+			NumCards:   len(pos.Hands[s]),
+			Tricks:     tricks[s],
+			NumTricks:  len(tricks[s]),
+			TrickScore: pos.Points[s],
+			IsNext:     pos.NextSeat() == s,
+			Seat:       cards.Seat(s),
+		}
+		if i == 0 {
+			ps.Cards = pos.Hands[s].Copy()
+		}
+		gs.Players = append(gs.Players, ps)
+	}
+	return gs
+}
+
+// Returns the GameState that the next player to play would see, from a GameState shown
+// to anyone, such as an observer. Returns false if nobody is due to play.
+func GameStateForNextPlayer(gs client.GameState) (client.GameState, bool) {
+	next := -1
+	for i, p := range gs.Players {
+		if p.IsNext {
+			next = i
+		}
+	}
+	if gs.Phase != client.Playing || next < 0 || len(gs.LegalPlays) == 0 {
+		return gs, false
+	}
+	view := gs
+	view.Players = nil
+	for i := range gs.Players {
+		p := gs.Players[(next+i)%len(gs.Players)]
+		if i > 0 {
+			p.Cards = nil
+		}
+		view.Players = append(view.Players, p)
+	}
+	return view, true
+}
+
+// Returns the Position for a GameState in which every player's cards are visible, such as
+// an observer's view, so that it can be solved. Seat 0 is gs.Players[0].
+// Returns false if some cards are hidden or the game isn't being played.
+func PositionFromGameState(gs client.GameState) (*hearts.Position, bool) {
+	if gs.Phase != client.Playing {
+		return nil, false
+	}
+	var hands [4]cards.Cards
+	next := -1
+	for seat, p := range gs.Players {
+		if len(p.Cards) != p.NumCards {
+			return nil, false
+		}
+		hands[seat] = p.Cards.Copy()
+		if p.IsNext {
+			next = seat
+		}
+	}
+	if next < 0 {
+		return nil, false
+	}
+	pos := positionFromGameState(gs, hands)
+	pos.Leader = (next - len(gs.CurrentTrick) + 4) % 4
+	return pos, true
+}
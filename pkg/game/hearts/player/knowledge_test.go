@@ -0,0 +1,66 @@
+package player
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// Plays a random deal with basic strategy for every seat, calling check before every play.
+func playBasicHand(check func(pos *hearts.Position, gs client.GameState)) {
+	pos := &hearts.Position{FirstTrick: true}
+	for i, h := range cards.Deal(4) {
+		pos.Hands[i] = h
+		if h.ContainsCard(cards.C2c) {
+			pos.Leader = i
+		}
+	}
+	var tricks [4][]cards.Cards
+	for !pos.IsOver() {
+		gs := GameStateForSeat(pos, tricks, pos.NextSeat())
+		check(pos, gs)
+		trick := append(pos.Trick.Copy(), ChooseBasicStrategyCard(gs))
+		pos.Play(trick[len(trick)-1])
+		if len(trick) == 4 {
+			tricks[pos.Leader] = append(tricks[pos.Leader], trick)
+		}
+	}
+}
+
+func TestKnowledgeMatchesDeal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		playBasicHand(func(pos *hearts.Position, gs client.GameState) {
+			if _, ok := reconstructTricks(gs); !ok {
+				t.Fatalf("reconstructTricks failed for %v", gs)
+			}
+			k := newKnowledge(gs)
+			me := pos.NextSeat()
+			for seat := 1; seat < 4; seat++ {
+				hand := pos.Hands[(me+seat)%4]
+				for _, s := range cards.Suits {
+					if k.voids[seat][s] && hand.ContainsSuit(s) {
+						t.Fatalf("seat %d holds %s but was inferred void: %v", seat, s, gs)
+					}
+				}
+			}
+			hands := k.sampleHands(gs, rng)
+			if hands == nil {
+				t.Fatalf("sampleHands found no deal for %v", gs)
+			}
+			for seat := 0; seat < 4; seat++ {
+				if len(hands[seat]) != len(pos.Hands[(me+seat)%4]) {
+					t.Fatalf("sampled hand %d has %d cards, want %d", seat, len(hands[seat]), len(pos.Hands[(me+seat)%4]))
+				}
+				for _, c := range hands[seat] {
+					if seat > 0 && k.voids[seat][c.Suit] {
+						t.Fatalf("sampled %s for seat %d which is void in %s", c, seat, c.Suit)
+					}
+				}
+			}
+		})
+	}
+}
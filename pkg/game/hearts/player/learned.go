@@ -0,0 +1,242 @@
+package player
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+// LearnedStrategy estimates the points each legal play will cost with a linear function of
+// features of the play, and chooses the cheapest. The weights are learned from self-play
+// by cmd/train.
+
+// Weights written by cmd/train and used when no weights file is given.
+//
+//go:embed learned_weights.json
+var defaultLearnedWeights []byte
+
+// The names of the features, in the order that PlayFeatures returns them.
+var LearnedFeatureNames = []string{
+	"bias",
+	"tricks_left",
+	"points_taken",
+	"card_value",
+	"queen_of_spades",
+	"heart",
+	"heart_value",
+	"high_spade_queen_out",
+	"lead",
+	"lead_value",
+	"lead_higher_out",
+	"lead_lower_out",
+	"lead_spade_queen_out",
+	"lead_heart",
+	"follow",
+	"follow_wins",
+	"follow_wins_points",
+	"follow_wins_last",
+	"follow_wins_to_play",
+	"follow_under_gap",
+	"follow_value",
+	"follow_wins_value",
+	"follow_high_spade_queen_out",
+	"discard",
+	"discard_points",
+	"discard_value",
+	"discard_high_spade_queen_out",
+	"suit_left",
+	"makes_void",
+}
+
+type LearnedWeights struct {
+	Features []string  `json:"features"`
+	Weights  []float64 `json:"weights"`
+}
+
+// Reads weights from a file written by Write, or the default weights if path is "".
+func ReadLearnedWeights(path string) (*LearnedWeights, error) {
+	name, b := "default weights", defaultLearnedWeights
+	if path != "" {
+		var err error
+		name = path
+		if b, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	var w LearnedWeights
+	if err := json.Unmarshal(b, &w); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if len(w.Features) != len(LearnedFeatureNames) || len(w.Weights) != len(LearnedFeatureNames) {
+		return nil, fmt.Errorf("%s has %d features and %d weights, want %d", name, len(w.Features), len(w.Weights), len(LearnedFeatureNames))
+	}
+	for i, f := range LearnedFeatureNames {
+		if w.Features[i] != f {
+			return nil, fmt.Errorf("%s has feature %s where %s was expected", name, w.Features[i], f)
+		}
+	}
+	return &w, nil
+}
+
+func (w *LearnedWeights) Write(path string) error {
+	b, err := json.MarshalIndent(w, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// Estimated points taken from now to the end of the hand after playing c,
+// compared with the average legal play.
+func (w *LearnedWeights) Predict(gs client.GameState, c cards.Card) float64 {
+	total := 0.0
+	for i, f := range PlayFeatures(gs, c) {
+		total += w.Weights[i] * f
+	}
+	return total
+}
+
+func NewLearnedStrategy(w *LearnedWeights) PlayerStrategy {
+	return &learnedStrategy{weights: w}
+}
+
+type learnedStrategy struct {
+	weights *LearnedWeights
+}
+
+func (s *learnedStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	legalPlays := gs.LegalPlays
+	best := legalPlays[0]
+	bestPoints := s.weights.Predict(gs, best)
+	for _, c := range legalPlays[1:] {
+		if p := s.weights.Predict(gs, c); p < bestPoints {
+			best, bestPoints = c, p
+		}
+	}
+	return best
+}
+
+// Describes playing c in this GameState as numbers for the learned strategy,
+// in the order of LearnedFeatureNames. Most are scaled to lie between 0 and 1.
+func PlayFeatures(gs client.GameState, c cards.Card) []float64 {
+	f := make([]float64, 0, len(LearnedFeatureNames))
+	add := func(v float64) { f = append(f, v) }
+	flag := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	hand := gs.Players[0].Cards
+	trick := gs.CurrentTrick
+	unseen := unseenCards(gs)
+	value := float64(c.Value-cards.Two) / 12
+	queenOut := unseen.ContainsCard(cards.Cqs)
+	lead := len(trick) == 0
+	follow := !lead && c.Suit == trick[0].Suit
+	discard := !lead && !follow
+
+	add(1)
+	add(float64(len(hand)) / 13)
+	add(float64(gs.Players[0].TrickScore) / 26)
+	add(value)
+	add(flag(c == cards.Cqs))
+	add(flag(c.Suit == cards.Hearts))
+	add(flag(c.Suit == cards.Hearts) * value)
+	add(flag(queenOut && (c == cards.Cks || c == cards.Cas)))
+
+	add(flag(lead))
+	add(flag(lead) * value)
+	add(flag(lead) * float64(numHigher(unseen, c)) / 13)
+	add(flag(lead) * float64(len(unseen.FilterBySuit(c.Suit))-numHigher(unseen, c)) / 13)
+	add(flag(lead && c.Suit == cards.Spades && queenOut))
+	add(flag(lead && c.Suit == cards.Hearts))
+
+	wins := false
+	gap := 0.0
+	if follow {
+		leadingCard := trick.LeadingCardOfTrick()
+		wins = c.Value > leadingCard.Value
+		if !wins {
+			gap = float64(leadingCard.Value-c.Value) / 12
+		}
+	}
+	toPlay := 3 - len(trick)
+	add(flag(follow))
+	add(flag(wins))
+	add(flag(wins) * float64(trickScore(trick)) / 13)
+	add(flag(wins && toPlay == 0))
+	add(flag(wins) * float64(toPlay) / 3)
+	add(gap)
+	add(flag(follow) * value)
+	add(flag(wins) * value)
+	add(flag(follow && toPlay > 0 && queenOut && (c == cards.Cks || c == cards.Cas)))
+
+	add(flag(discard))
+	add(flag(discard) * float64(trickScore(cards.Cards{c})) / 13)
+	add(flag(discard) * value)
+	add(flag(discard && queenOut && (c == cards.Cks || c == cards.Cas)))
+
+	suitLeft := len(hand.FilterBySuit(c.Suit)) - 1
+	add(float64(suitLeft) / 13)
+	add(flag(suitLeft == 0 && len(hand) > 1))
+	return f
+}
+
+// One play made during training, and the points its player went on to take.
+type LearnedSample struct {
+	Features []float64
+	Points   float64
+}
+
+// Fits weights to samples by least squares, with ridge regularization
+// to keep weights for rarely seen features small.
+func FitLearnedWeights(samples []LearnedSample, ridge float64) (*LearnedWeights, error) {
+	n := len(LearnedFeatureNames)
+	// Solve (XᵀX + ridge·I) w = Xᵀy as an augmented matrix.
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, n+1)
+		a[i][i] = ridge
+	}
+	for _, s := range samples {
+		for i, fi := range s.Features {
+			for j, fj := range s.Features {
+				a[i][j] += fi * fj
+			}
+			a[i][n] += fi * s.Points
+		}
+	}
+	// Gaussian elimination with partial pivoting.
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if a[pivot][col] == 0 {
+			return nil, fmt.Errorf("feature %s is never used", LearnedFeatureNames[col])
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for row := 0; row < n; row++ {
+			if row == col {
+				continue
+			}
+			factor := a[row][col] / a[col][col]
+			for k := col; k <= n; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+		}
+	}
+	w := &LearnedWeights{Features: LearnedFeatureNames, Weights: make([]float64, n)}
+	for i := range w.Weights {
+		w.Weights[i] = a[i][n] / a[i][i]
+	}
+	return w, nil
+}
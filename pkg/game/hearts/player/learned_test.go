@@ -0,0 +1,39 @@
+package player
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestFitLearnedWeights(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	want := make([]float64, len(LearnedFeatureNames))
+	for i := range want {
+		want[i] = float64(i%5) - 2
+	}
+	var samples []LearnedSample
+	for i := 0; i < 1000; i++ {
+		s := LearnedSample{Features: make([]float64, len(want))}
+		for j := range s.Features {
+			s.Features[j] = rng.Float64()
+			s.Points += want[j] * s.Features[j]
+		}
+		samples = append(samples, s)
+	}
+	w, err := FitLearnedWeights(samples, 0.001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range LearnedFeatureNames {
+		if math.Abs(w.Weights[i]-want[i]) > 0.01 {
+			t.Errorf("weight for %s: got %.3f, want %.3f", name, w.Weights[i], want[i])
+		}
+	}
+}
+
+func TestDefaultLearnedWeights(t *testing.T) {
+	if _, err := ReadLearnedWeights(""); err != nil {
+		t.Errorf("ReadLearnedWeights(\"\"): %v", err)
+	}
+}
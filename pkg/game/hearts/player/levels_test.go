@@ -0,0 +1,39 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+func TestLevelForgetsOldTricks(t *testing.T) {
+	first := cards.Cards{cards.C2c, cards.Cac, cards.C5c, cards.Ctc}
+	second := cards.Cards{cards.C3d, cards.Cqs, cards.C4d, cards.C5d}
+	gs := moonGameState(cards.Cards{cards.C9h}, nil, map[int][]cards.Cards{
+		1: {first},
+		2: {second},
+	})
+	s := NewLevelStrategy(Level{Memory: 1}).(*levelStrategy)
+	s.GameStarted(gs)
+	s.TrickCompleted(first, cards.Cac, "")
+	s.TrickCompleted(second, cards.C5d, "")
+
+	remembered := s.forget(gs)
+	if len(remembered.Players[1].Tricks) != 0 {
+		t.Errorf("first trick is still remembered: %v", remembered.Players[1].Tricks)
+	}
+	if len(remembered.Players[2].Tricks) != 1 {
+		t.Errorf("second trick was forgotten")
+	}
+	if len(gs.Players[1].Tricks) != 1 {
+		t.Errorf("forget changed the original GameState")
+	}
+}
+
+func TestLevelsFromFlag(t *testing.T) {
+	for _, l := range Levels {
+		if _, err := NewStrategyFromFlag(l.Name); err != nil {
+			t.Errorf("NewStrategyFromFlag(%q): %v", l.Name, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package player
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+// MonteCarloStrategy deals the hidden cards many times, consistent with what has been seen,
+// plays out the rest of the hand for each legal play, and picks the play with the
+// lowest average score. Near the end of the hand, each sampled deal is solved exactly.
+
+type MonteCarloConfig struct {
+	Samples       int           // Number of deals to sample for each play.
+	TimeBudget    time.Duration // Stop sampling after this long, even if fewer samples are done. 0 means no limit.
+	EndgameTricks int           // With this many tricks left, solve sampled deals instead of playing them out.
+}
+
+var DefaultMonteCarloConfig = MonteCarloConfig{
+	Samples:       100,
+	TimeBudget:    time.Second,
+	EndgameTricks: 4,
+}
+
+func NewMonteCarloStrategy(config MonteCarloConfig) PlayerStrategy {
+	return &monteCarloStrategy{
+		config: config,
+		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		solver: hearts.NewSolver(),
+	}
+}
+
+type monteCarloStrategy struct {
+	config  MonteCarloConfig
+	rng     *rand.Rand
+	solver  *hearts.Solver
+	tracker *Tracker
+}
+
+func (s *monteCarloStrategy) SetTracker(t *Tracker) {
+	s.tracker = t
+}
+
+// Parses options of the form "samples=200,time=500ms,endgame=4".
+func parseMonteCarloOptions(options string) (MonteCarloConfig, error) {
+	config := DefaultMonteCarloConfig
+	if options == "" {
+		return config, nil
+	}
+	for _, opt := range strings.Split(options, ",") {
+		name, value, _ := strings.Cut(opt, "=")
+		var err error
+		switch name {
+		case "samples":
+			config.Samples, err = strconv.Atoi(value)
+		case "time":
+			config.TimeBudget, err = time.ParseDuration(value)
+		case "endgame":
+			config.EndgameTricks, err = strconv.Atoi(value)
+		default:
+			err = fmt.Errorf("unknown option")
+		}
+		if err != nil {
+			return config, fmt.Errorf("invalid mc option %s: %v", opt, err)
+		}
+	}
+	return config, nil
+}
+
+func (s *monteCarloStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	legalPlays := gs.LegalPlays
+	if len(legalPlays) == 1 {
+		return legalPlays[0]
+	}
+	k := newKnowledge(gs)
+	if s.tracker != nil {
+		k = s.tracker.knowledge(gs)
+	}
+	totals := s.sampleScores(gs, k)
+	if totals == nil {
+		return ChooseBasicStrategyCard(gs)
+	}
+	best := 0
+	for i := range legalPlays {
+		if totals[i] < totals[best] {
+			best = i
+		}
+	}
+	return legalPlays[best]
+}
+
+// Returns the total score over all samples for each of the legal plays,
+// or nil if no samples could be dealt.
+func (s *monteCarloStrategy) sampleScores(gs client.GameState, k knowledge) []int {
+	legalPlays := gs.LegalPlays
+	totals := make([]int, len(legalPlays))
+	deadline := time.Now().Add(s.config.TimeBudget)
+	numSamples := 0
+	for numSamples < s.config.Samples {
+		if s.config.TimeBudget > 0 && numSamples > 0 && time.Now().After(deadline) {
+			break
+		}
+		hands := k.sampleHands(gs, s.rng)
+		if hands == nil {
+			break
+		}
+		pos := positionFromGameState(gs, *hands)
+		if pos.TricksLeft() <= s.config.EndgameTricks {
+			if err := s.addSolvedScores(pos, legalPlays, totals); err != nil {
+				break
+			}
+			numSamples++
+			continue
+		}
+		for i, c := range legalPlays {
+			p := pos.Clone()
+			p.Play(c)
+			p.PlayOut()
+			totals[i] += p.HandScores()[0]
+		}
+		numSamples++
+	}
+	if numSamples == 0 {
+		return nil
+	}
+	return totals
+}
+
+// Adds the exact score of each legal play in a fully known position to totals.
+func (s *monteCarloStrategy) addSolvedScores(pos *hearts.Position, legalPlays cards.Cards, totals []int) error {
+	values, err := s.solver.EvaluatePlays(pos)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		for i, c := range legalPlays {
+			if c == v.Card {
+				totals[i] += v.Score
+			}
+		}
+	}
+	return nil
+}
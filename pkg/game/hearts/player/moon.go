@@ -0,0 +1,226 @@
+package player
+
+import (
+	"fmt"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+// Shooting the moon: taking every point card scores 0 for the shooter and 26 for everyone else.
+// The strategies switch to taking tricks when the hand looks strong enough to take them all,
+// and try to keep a heart away from an opponent who has taken every point so far.
+
+// Minimum points an opponent must have taken, with nobody else taking any, before we defend.
+const moonDefenseThreshold = 10
+
+// Chooses a card to shoot the moon or to stop an opponent from shooting it.
+// Returns false if neither applies and the usual strategy should be used.
+func chooseMoonCard(gs client.GameState) (Explanation, bool) {
+	if len(gs.LegalPlays) == 1 {
+		return Explanation{}, false
+	}
+	if canShootMoon(gs) {
+		return chooseShootingCard(gs), true
+	}
+	if shooter, ok := moonShooter(gs); ok {
+		return chooseMoonDefenseCard(gs, shooter)
+	}
+	return Explanation{}, false
+}
+
+// Describes any moon-shot plan for this turn, or returns "" if there is none.
+func MoonHint(gs client.GameState) string {
+	if canShootMoon(gs) {
+		return "Your hand can take every trick: try to shoot the moon."
+	}
+	if shooter, ok := moonShooter(gs); ok {
+		p := gs.Players[shooter]
+		return fmt.Sprintf("%s has taken all %d points so far and may be shooting the moon: keep a heart to stop them.",
+			p.Name, pointsInTricks(p.Tricks))
+	}
+	return ""
+}
+
+// Reports whether we can still take every point and expect to win almost every trick.
+func canShootMoon(gs client.GameState) bool {
+	for _, p := range gs.Players[1:] {
+		if pointsInTricks(p.Tricks) > 0 {
+			return false
+		}
+	}
+	losers := countMoonLosers(gs)
+	if losers == 0 {
+		return true
+	}
+	// One loser is worth the risk once we've started collecting points.
+	return losers == 1 && pointsInTricks(gs.Players[0].Tricks) > 0
+}
+
+// Estimates the number of tricks we'd lose while trying to take them all.
+// A suit runs if our top cards can win enough rounds to draw out everyone else's cards.
+func countMoonLosers(gs client.GameState) int {
+	hand := gs.Players[0].Cards
+	unseen := unseenCards(gs)
+	losers := 0
+	for _, suit := range cards.Suits {
+		mine := hand.FilterBySuit(suit)
+		others := unseen.FilterBySuit(suit)
+		if len(mine) == 0 || len(others) == 0 {
+			continue
+		}
+		winners := numTopCards(mine, others)
+		// Assume the other cards could be split unevenly between two players.
+		rounds := (len(others) + 1) / 2
+		if winners < rounds {
+			losers += len(mine) - winners
+		}
+	}
+	return losers
+}
+
+// Number of cards in mine that beat every card in others of the same suit, counting down from the top.
+func numTopCards(mine, others cards.Cards) int {
+	sorted := mine.Copy()
+	sorted.Sort()
+	n := 0
+	for i := len(sorted) - 1; i >= 0 && numHigher(others, sorted[i]) == 0; i-- {
+		n++
+	}
+	return n
+}
+
+func chooseShootingCard(gs client.GameState) Explanation {
+	legalPlays := gs.LegalPlays
+	trick := gs.CurrentTrick
+	if len(trick) == 0 {
+		// Lead a winner from our longest running suit.
+		unseen := unseenCards(gs)
+		var best cards.Cards
+		for _, plays := range legalPlays.SplitBySuit() {
+			top := plays.Highest()
+			if numHigher(unseen, top) == 0 && len(plays) > len(best) {
+				best = plays
+			}
+		}
+		if len(best) > 0 {
+			return explain(best.Highest(), "moon-lead-winner",
+				"Shooting the moon: leading the %s, which nobody can beat", cardName(best.Highest()))
+		}
+		return explain(legalPlays.Highest(), "moon-lead-high",
+			"Shooting the moon: leading your highest card to win the lead back")
+	}
+	leadSuit := trick[0].Suit
+	if !legalPlays.ContainsSuit(leadSuit) {
+		// Keep the points for ourselves.
+		nonPoints := legalPlays.Filter(func(c cards.Card) bool { return c.Suit != cards.Hearts && c != cards.Cqs })
+		if len(nonPoints) > 0 {
+			return explain(nonPoints.Lowest(), "moon-keep-points",
+				"Shooting the moon: keeping your points for yourself while you can't follow %s", leadSuit.Name())
+		}
+		return explain(legalPlays.Lowest(), "moon-keep-points",
+			"Shooting the moon: playing your lowest point card while you can't follow %s", leadSuit.Name())
+	}
+	leadingCard := trick.LeadingCardOfTrick()
+	if legalPlays.Highest().Value < leadingCard.Value {
+		return explain(legalPlays.Lowest(), "moon-cant-win",
+			"Shooting the moon, but you can't beat the %s: saving your high %s", cardName(leadingCard), leadSuit.Name())
+	}
+	if len(trick) == 3 {
+		// Win as cheaply as we can.
+		c := legalPlays.Filter(func(c cards.Card) bool { return c.Value > leadingCard.Value }).Lowest()
+		return explain(c, "moon-win-cheap",
+			"Shooting the moon: the %s is the cheapest card that takes the trick", cardName(c))
+	}
+	return explain(legalPlays.Highest(), "moon-win-high", "Shooting the moon: taking the trick with your highest %s", leadSuit.Name())
+}
+
+// Returns the seat of an opponent who has taken every point so far, if there is one
+// and there are still points left to keep from them.
+func moonShooter(gs client.GameState) (int, bool) {
+	shooter := -1
+	for seat, p := range gs.Players {
+		if pointsInTricks(p.Tricks) == 0 {
+			continue
+		}
+		if shooter >= 0 || seat == 0 {
+			return 0, false
+		}
+		shooter = seat
+	}
+	if shooter < 0 || pointsInTricks(gs.Players[shooter].Tricks) < moonDefenseThreshold {
+		return 0, false
+	}
+	taken := 0
+	for _, p := range gs.Players {
+		taken += pointsInTricks(p.Tricks)
+	}
+	return shooter, taken < 26
+}
+
+func chooseMoonDefenseCard(gs client.GameState, shooter int) (Explanation, bool) {
+	name := gs.Players[shooter].Name
+	legalPlays := gs.LegalPlays
+	trick := gs.CurrentTrick
+	if len(trick) == 0 {
+		// Lead a heart nobody can beat, to take a point ourselves.
+		hearts := legalPlays.FilterBySuit(cards.Hearts)
+		if len(hearts) > 0 && numHigher(unseenCards(gs), hearts.Highest()) == 0 {
+			return explain(hearts.Highest(), "moon-defense-lead",
+				"Leading the %s, which nobody can beat, to take a heart before %s takes them all", cardName(hearts.Highest()), name), true
+		}
+		return Explanation{}, false
+	}
+	leadSuit := trick[0].Suit
+	leadingCard := trick.LeadingCardOfTrick()
+	if legalPlays.ContainsSuit(leadSuit) {
+		// Take a trick with points in it if we can.
+		if (trickScore(trick) > 0 || leadSuit == cards.Hearts) && legalPlays.Highest().Value > leadingCard.Value {
+			return explain(legalPlays.Highest(), "moon-defense-take",
+				"Taking this trick's points with the %s so %s can't shoot the moon", cardName(legalPlays.Highest()), name), true
+		}
+		return Explanation{}, false
+	}
+	winner := (currentTrickLeader(gs) + trickWinnerIndex(trick)) % 4
+	nonPoints := legalPlays.Filter(func(c cards.Card) bool { return c.Suit != cards.Hearts && c != cards.Cqs })
+	if winner == shooter {
+		// Keep our hearts away from the shooter.
+		if len(nonPoints) > 0 {
+			return explain(nonPoints.Highest(), "moon-defense-keep-hearts",
+				"%s is winning this trick, so keeping your hearts away from them", name), true
+		}
+		return Explanation{}, false
+	}
+	// Someone else is winning this trick, so giving them points spoils the moon.
+	if legalPlays.ContainsCard(cards.Cqs) {
+		return explain(cards.Cqs, "moon-defense-spoil",
+			"Giving the %s to someone other than %s spoils their moon shot", cardName(cards.Cqs), name), true
+	}
+	hearts := legalPlays.FilterBySuit(cards.Hearts)
+	// Keep our highest heart to take a heart trick later.
+	if len(hearts) > 1 {
+		hearts.Sort()
+		return explain(hearts[len(hearts)-2], "moon-defense-spoil",
+			"Giving a heart to someone other than %s spoils their moon shot; keeping your highest heart for later", name), true
+	}
+	return Explanation{}, false
+}
+
+// Index in trick of the card that is winning it.
+func trickWinnerIndex(trick cards.Cards) int {
+	leadingCard := trick.LeadingCardOfTrick()
+	for i, c := range trick {
+		if c == leadingCard {
+			return i
+		}
+	}
+	return 0
+}
+
+func pointsInTricks(tricks []cards.Cards) int {
+	points := 0
+	for _, t := range tricks {
+		points += trickScore(t)
+	}
+	return points
+}
@@ -0,0 +1,82 @@
+package player
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+)
+
+// Builds a GameState for seat 0 where the given seats have taken the given tricks.
+func moonGameState(hand, trick cards.Cards, tricks map[int][]cards.Cards) client.GameState {
+	gs := client.GameState{
+		Phase:        client.Playing,
+		CurrentTrick: trick,
+		LegalPlays:   hand,
+	}
+	for seat, name := range []string{"Me", "West", "North", "East"} {
+		ps := client.PlayerState{Name: name, Tricks: tricks[seat]}
+		if seat == 0 {
+			ps.Cards = hand
+		}
+		gs.Players = append(gs.Players, ps)
+	}
+	return gs
+}
+
+func TestMoonHint(t *testing.T) {
+	tests := []struct {
+		name string
+		gs   client.GameState
+		want string
+	}{
+		{
+			name: "shooting hand",
+			gs:   moonGameState(cards.Cards{cards.Cac, cards.Cah, cards.Ckh, cards.Cqh, cards.Cjh}, nil, nil),
+			want: "shoot the moon",
+		},
+		{
+			name: "opponent has every point",
+			gs: moonGameState(cards.Cards{cards.C2c, cards.C2h}, nil, map[int][]cards.Cards{
+				2: {{cards.C3s, cards.Cqs, cards.C4s, cards.C5s}},
+			}),
+			want: "North has taken all 13 points",
+		},
+		{
+			name: "points are shared",
+			gs: moonGameState(cards.Cards{cards.C2c, cards.C2h}, nil, map[int][]cards.Cards{
+				1: {{cards.C3d, cards.C4h, cards.C4d, cards.C5d}},
+				2: {{cards.C3s, cards.Cqs, cards.C4s, cards.C5s}},
+			}),
+			want: "",
+		},
+	}
+	for _, tc := range tests {
+		got := MoonHint(tc.gs)
+		if (tc.want == "") != (got == "") || !strings.Contains(got, tc.want) {
+			t.Errorf("%s: MoonHint()=%q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestMoonDefense(t *testing.T) {
+	shooterTricks := []cards.Cards{{cards.C3s, cards.Cqs, cards.C4s, cards.C5s}}
+	hand := cards.Cards{cards.C3c, cards.C2h, cards.C9h}
+	// North led 5d and East is winning with Kd.
+	trick := cards.Cards{cards.C5d, cards.Ckd}
+	tests := []struct {
+		name    string
+		shooter int
+		want    cards.Card
+	}{
+		{"shooter winning the trick", 3, cards.C3c},
+		{"someone else winning the trick", 2, cards.C2h},
+	}
+	for _, tc := range tests {
+		gs := moonGameState(hand, trick, map[int][]cards.Cards{tc.shooter: shooterTricks})
+		if got := ChooseBasicStrategyCard(gs); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
@@ -1,26 +1,104 @@
 package player
 
 import (
+	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/mpsalisbury/cards/pkg/client"
 )
 
+var playerTypes = []string{"basic", "tracker", "term", "random", "mc", "exec", "learned", "novice", "intermediate", "expert"}
+
 // Creates a flag for specifying the player type to use.
+// Some types take options after a colon, e.g. "mc:samples=200,time=500ms,endgame=4",
+// exec takes the bot program to run, e.g. "exec:/path/to/bot", and learned takes
+// a weights file written by cmd/train, e.g. "learned:weights.json".
 func AddPlayerFlag(target *string, name string) {
-	client.EnumFlag(target, name, []string{"basic", "term", "random"}, "Type of player logic to use")
+	usage := fmt.Sprintf("Type of player logic to use, must be one of %v (mc accepts options, e.g. mc:samples=200,time=500ms,endgame=4; exec runs a bot program, e.g. exec:/path/to/bot; learned accepts a weights file from cmd/train, e.g. learned:weights.json)", playerTypes)
+	flag.Func(name, usage, func(flagValue string) error {
+		if err := checkPlayerType(flagValue); err != nil {
+			return err
+		}
+		*target = flagValue
+		return nil
+	})
+}
+
+func checkPlayerType(playerType string) error {
+	kind, options, _ := strings.Cut(playerType, ":")
+	switch kind {
+	case "mc":
+		_, err := parseMonteCarloOptions(options)
+		return err
+	case "learned":
+		_, err := ReadLearnedWeights(options)
+		return err
+	case "exec":
+		if len(strings.Fields(options)) == 0 {
+			return fmt.Errorf("exec needs a bot program, e.g. exec:/path/to/bot")
+		}
+		return nil
+	case "basic", "tracker", "term", "random", "novice", "intermediate", "expert":
+		if options != "" {
+			return fmt.Errorf("player type %s doesn't take options", kind)
+		}
+		return nil
+	}
+	return fmt.Errorf("must be one of %v", playerTypes)
 }
 
 // Constructs a player from a player flag value.
 func NewPlayerFromFlag(playerType string, hints bool) (client.GameCallbacks, error) {
-	switch playerType {
+	if playerType == "term" {
+		return NewTerminalPlayer(hints), nil
+	}
+	strategy, err := NewStrategyFromFlag(playerType)
+	if err != nil {
+		return nil, err
+	}
+	return newStrategyPlayer(strategy), nil
+}
+
+// Constructs the strategy for a player flag value that plays without a person at the terminal.
+func NewStrategyFromFlag(playerType string) (PlayerStrategy, error) {
+	if playerType != "" {
+		if err := checkPlayerType(playerType); err != nil {
+			return nil, fmt.Errorf("invalid player type %s: %v", playerType, err)
+		}
+	}
+	kind, options, _ := strings.Cut(playerType, ":")
+	switch kind {
 	case "", "basic":
-		return newStrategyPlayer(newBasicStrategy()), nil
+		return newBasicStrategy(), nil
+	case "tracker":
+		return newTrackerStrategy(), nil
 	case "random":
-		return newStrategyPlayer(newRandomStrategy()), nil
+		return newRandomStrategy(), nil
+	case "mc":
+		config, err := parseMonteCarloOptions(options)
+		if err != nil {
+			return nil, err
+		}
+		return NewMonteCarloStrategy(config), nil
+	case "learned":
+		weights, err := ReadLearnedWeights(options)
+		if err != nil {
+			return nil, err
+		}
+		return NewLearnedStrategy(weights), nil
+	case "exec":
+		config := DefaultBotConfig
+		config.Command = strings.Fields(options)
+		return NewBotStrategy(config), nil
+	case "novice", "intermediate", "expert":
+		level, err := LevelByName(kind)
+		if err != nil {
+			return nil, err
+		}
+		return NewLevelStrategy(level), nil
 	case "term":
-		return NewTerminalPlayer(hints), nil
-	default:
-		return nil, fmt.Errorf("invalid player type %s", playerType)
+		return nil, fmt.Errorf("player type term needs a person to play")
 	}
+	return nil, fmt.Errorf("invalid player type %s", playerType)
 }
@@ -0,0 +1,82 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/client"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"github.com/mpsalisbury/cards/pkg/server"
+)
+
+// Returns a server.BotHost that plays server-side bots with the strategies in this package.
+// Each bot is an ordinary player connected to the server in-process.
+func NewBotHost() server.BotHost {
+	return botHost{}
+}
+
+type botHost struct{}
+
+// Limits on the search of mc bots, since other players choose their options.
+const (
+	maxBotSamples       = 1000
+	maxBotTimeBudget    = 2 * time.Second
+	maxBotEndgameTricks = 5
+)
+
+func (botHost) CheckPlayerType(playerType string) error {
+	kind, options, _ := strings.Cut(playerType, ":")
+	switch {
+	case kind == "exec", kind == "term":
+		return fmt.Errorf("player type %s can't be played by the server", kind)
+	case kind == "learned" && options != "":
+		// Other players choose bot types, so they mustn't name files on the server.
+		return fmt.Errorf("learned bots use the built-in weights")
+	}
+	if err := checkPlayerType(playerType); err != nil {
+		return fmt.Errorf("invalid player type %s: %v", playerType, err)
+	}
+	if kind == "mc" {
+		config, _ := parseMonteCarloOptions(options)
+		switch {
+		case config.Samples > maxBotSamples:
+			return fmt.Errorf("mc bots on the server take at most %d samples", maxBotSamples)
+		case config.TimeBudget <= 0 || config.TimeBudget > maxBotTimeBudget:
+			return fmt.Errorf("mc bots on the server take at most %v a turn", maxBotTimeBudget)
+		case config.EndgameTricks > maxBotEndgameTricks:
+			return fmt.Errorf("mc bots on the server solve at most the last %d tricks", maxBotEndgameTricks)
+		}
+	}
+	return nil
+}
+
+func (h botHost) StartBot(service pb.CardGameServiceServer, gameId, playerType string) error {
+	if err := h.CheckPlayerType(playerType); err != nil {
+		return err
+	}
+	strategy, err := NewStrategyFromFlag(playerType)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := client.ConnectToService(service, false)
+	session, err := conn.RegisterBot(ctx, playerType+" bot", newStrategyPlayer(strategy))
+	if err != nil {
+		cancel()
+		return err
+	}
+	wg := new(sync.WaitGroup)
+	if err := session.JoinGame(ctx, wg, gameId); err != nil {
+		cancel()
+		return err
+	}
+	go func() {
+		// The bot's session ends with its game.
+		wg.Wait()
+		cancel()
+	}()
+	return nil
+}
@@ -0,0 +1,29 @@
+package player
+
+import "testing"
+
+func TestCheckServerBotType(t *testing.T) {
+	host := NewBotHost()
+	for _, tc := range []struct {
+		playerType string
+		ok         bool
+	}{
+		{"basic", true},
+		{"expert", true},
+		{"mc", true},
+		{"mc:samples=200,time=500ms", true},
+		{"mc:samples=100000000,time=24h", false},
+		{"mc:samples=200", true},
+		{"mc:time=0s", false},
+		{"mc:time=1m", false},
+		{"mc:endgame=13", false},
+		{"exec:/bin/sh", false},
+		{"learned:/etc/passwd", false},
+		{"term", false},
+	} {
+		err := host.CheckPlayerType(tc.playerType)
+		if (err == nil) != tc.ok {
+			t.Errorf("CheckPlayerType(%q) = %v, want ok %v", tc.playerType, err, tc.ok)
+		}
+	}
+}
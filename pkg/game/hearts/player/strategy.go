@@ -3,7 +3,7 @@ package player
 import (
 	"context"
 	"fmt"
-	"log"
+	"io"
 
 	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
@@ -13,25 +13,114 @@ type PlayerStrategy interface {
 	ChooseCardToPlay(client.GameState) cards.Card
 }
 
+// ExplainingStrategy is implemented by strategies that can say why they chose a card.
+type ExplainingStrategy interface {
+	PlayerStrategy
+	ExplainCardToPlay(client.GameState) Explanation
+}
+
+// Why a strategy chose a card.
+type Explanation struct {
+	Card   cards.Card
+	Rule   string // Short, stable name of the rule that chose the card, e.g. "dump-queen".
+	Reason string // Sentence describing the choice, for people.
+}
+
+// GameObserver is implemented by strategies that also want to hear about the game's events.
+type GameObserver interface {
+	GameStarted(client.GameState)
+	TrickCompleted(trick cards.Cards, winningCard cards.Card, winnerId string)
+	GameFinished(client.GameState)
+}
+
 func newStrategyPlayer(strategy PlayerStrategy) client.GameCallbacks {
-	return &strategyPlayer{strategy: strategy}
+	return newPlayer(strategy, AsHistoryStrategy(strategy))
+}
+
+// Constructs a player that chooses cards with the whole history of the hand.
+func NewHistoryStrategyPlayer(strategy HistoryStrategy) client.GameCallbacks {
+	return newPlayer(strategy, strategy)
+}
+
+// strategy is checked for the optional interfaces, such as GameObserver; chooser picks the cards.
+func newPlayer(strategy any, chooser HistoryStrategy) *strategyPlayer {
+	p := &strategyPlayer{strategy: strategy, chooser: chooser, tracker: NewTracker()}
+	if ts, ok := strategy.(TrackingStrategy); ok {
+		ts.SetTracker(p.tracker)
+	}
+	return p
 }
 
 type strategyPlayer struct {
 	client.UnimplementedGameCallbacks
-	strategy PlayerStrategy
+	strategy any
+	chooser  HistoryStrategy
+	tracker  *Tracker
+	history  HandHistory
+}
+
+func (p *strategyPlayer) HandleGameStarted(s client.Session, gameId string) error {
+	gameState, err := s.GetGameState(context.Background(), gameId)
+	if err != nil {
+		return fmt.Errorf("couldn't get game state: %w", err)
+	}
+	p.tracker.StartHand(gameState)
+	p.history.Reset()
+	if o, ok := p.strategy.(GameObserver); ok {
+		o.GameStarted(gameState)
+	}
+	return nil
 }
 
-func (p strategyPlayer) HandleYourTurn(s client.Session, gameId string) error {
+func (p *strategyPlayer) HandleCardPlayed(s client.Session, gameId string, card cards.Card, playerId, playerName string) error {
+	p.history.AddPlay(playerId, card)
+	return nil
+}
+
+func (p *strategyPlayer) HandleTrickCompleted(s client.Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error {
+	p.history.CompleteTrick(winnerId)
+	p.tracker.TrickCompleted(trick, winningCard, winnerId)
+	if o, ok := p.strategy.(GameObserver); ok {
+		o.TrickCompleted(trick, winningCard, winnerId)
+	}
+	return nil
+}
+
+func (p *strategyPlayer) HandleGameFinished(s client.Session, gameId string) {
+	if o, ok := p.strategy.(GameObserver); ok {
+		if gameState, err := s.GetGameState(context.Background(), gameId); err == nil {
+			o.GameFinished(gameState)
+		}
+	}
+	p.close()
+}
+
+func (p *strategyPlayer) HandleGameAborted(s client.Session, gameId string) {
+	p.close()
+}
+
+// Releases anything the strategy holds, such as a bot process.
+func (p *strategyPlayer) close() {
+	if c, ok := p.strategy.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+func (p *strategyPlayer) HandleYourTurn(s client.Session, gameId string) error {
 	ctx := context.Background()
 	gameState, err := s.GetGameState(ctx, gameId)
 	if err != nil {
-		return fmt.Errorf("couldn't get game state: %v", err)
+		return fmt.Errorf("couldn't get game state: %w", err)
+	}
+	if !gameState.Players[0].IsNext {
+		// A turn sent again after reconnecting may have been played already.
+		return nil
 	}
-	card := p.strategy.ChooseCardToPlay(gameState)
+	p.tracker.ObserveTurn(gameState)
+	card := p.chooser.ChooseCardWithHistory(gameState, &p.history)
 	err = s.PlayCard(ctx, gameId, card)
 	if err != nil {
-		log.Fatalf("Player chose invalid card %s\nerror: %v\nGamestate: %v", card, err, gameState)
+		return fmt.Errorf("player chose invalid card %s\nerror: %w\nGamestate: %v", card, err, gameState)
 	}
 	return nil
 }
@@ -1,8 +1,10 @@
 package player
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/mpsalisbury/cards/pkg/cards"
@@ -12,12 +14,18 @@ import (
 // TerminalPlayer has user enter plays via terminal.
 
 func NewTerminalPlayer(hints bool) client.GameCallbacks {
-	return &terminalCallbacks{hints: hints}
+	return &terminalCallbacks{
+		hints:    hints,
+		notation: cards.TerminalNotation(os.Stdout),
+		input:    bufio.NewReader(os.Stdin),
+	}
 }
 
 type terminalCallbacks struct {
 	client.UnimplementedGameCallbacks
-	hints bool
+	hints    bool
+	notation cards.Notation
+	input    *bufio.Reader
 }
 
 func (c terminalCallbacks) HandleGameStarted(s client.Session, gameId string) error {
@@ -43,7 +51,7 @@ func (terminalCallbacks) playerNames(gameState client.GameState, pid string) (pl
 }
 
 func (c terminalCallbacks) HandleTrickCompleted(s client.Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error {
-	fmt.Printf("Trick: %s won by %s\n\n", trick, winnerName)
+	fmt.Printf("Trick: %s won by %s\n\n", c.notation.Cards(trick), winnerName)
 	return nil
 }
 
@@ -58,23 +66,31 @@ func (c terminalCallbacks) HandleYourTurn(s client.Session, gameId string) error
 		if err := s.PlayCard(ctx, gameId, card); err == nil {
 			return nil
 		}
-		fmt.Printf("Can't play card %s. Try again\n", card)
+		fmt.Printf("Can't play card %s. Try again\n", c.notation.Card(card))
 	}
 }
 
 func (c terminalCallbacks) chooseCard(gs client.GameState) cards.Card {
 	for {
-		recommended := ChooseBasicStrategyCard(gs)
-		fmt.Println(showGame(gs))
+		recommended := ExplainBasicStrategyCard(gs)
+		fmt.Println(c.showGame(gs))
 		if c.hints {
-			fmt.Printf("Enter card to play [%s]: ", recommended)
+			if hint := MoonHint(gs); hint != "" {
+				fmt.Printf("Hint: %s\n", hint)
+			}
+			fmt.Printf("Hint: %s.\n", recommended.Reason)
+			if evals := EvaluatePlays(gs, DefaultEvaluationConfig); len(evals) > 1 {
+				fmt.Printf("Expected points for each play:\n%s", FormatPlayEvaluations(evals, c.notation))
+			}
+			fmt.Printf("Enter card to play [%s]: ", c.notation.Card(recommended.Card))
 		} else {
 			fmt.Printf("Enter card to play: ")
 		}
-		var cs string
-		fmt.Scanln(&cs)
+		// Read the whole line so that "queen of spades" is accepted.
+		line, _ := c.input.ReadString('\n')
+		cs := strings.TrimSpace(line)
 		if cs == "" && c.hints {
-			return recommended
+			return recommended.Card
 		}
 		card, err := cards.ParseCard(cs)
 		if err == nil {
@@ -84,9 +100,9 @@ func (c terminalCallbacks) chooseCard(gs client.GameState) cards.Card {
 	}
 }
 
-func showGame(gs client.GameState) string {
+func (c terminalCallbacks) showGame(gs client.GameState) string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("Your hand: %s\n", gs.Players[0].Cards.HandString()))
-	sb.WriteString(fmt.Sprintf("Trick so far: %s", gs.CurrentTrick))
+	sb.WriteString(fmt.Sprintf("Your hand: %s\n", c.notation.Hand(gs.Players[0].Cards)))
+	sb.WriteString(fmt.Sprintf("Trick so far: %s", c.notation.Cards(gs.CurrentTrick)))
 	return sb.String()
 }
@@ -1,34 +1,231 @@
 package player
 
 import (
-	"context"
-	"fmt"
-
+	"github.com/mpsalisbury/cards/pkg/cards"
 	"github.com/mpsalisbury/cards/pkg/client"
 )
 
-// TrackerPlayer tracks other players' past behavior.
+// Tracker follows a hand through the game's events and remembers what each player has
+// shown: every card played, who has failed to follow suit, and who has taken points.
+// A strategyPlayer keeps a Tracker up to date and gives it to any strategy that
+// implements TrackingStrategy.
+//
+// Seats are numbered as described at the top of knowledge.go.
+type Tracker struct {
+	playerIds []string // by seat
+	played    cards.Cards
+	voids     [4][4]bool // [seat][suit] is true if the seat has shown out of the suit.
+	points    [4]int
+	numTricks int
+}
+
+// TrackingStrategy is a PlayerStrategy that also consults a Tracker.
+type TrackingStrategy interface {
+	PlayerStrategy
+	SetTracker(*Tracker)
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Starts tracking a new hand with the players in gs.
+func (t *Tracker) StartHand(gs client.GameState) {
+	*t = Tracker{}
+	for _, p := range gs.Players {
+		t.playerIds = append(t.playerIds, p.Id)
+	}
+}
+
+// Records a completed trick. The winner's seat and the position of the winning card
+// show who led the trick, and so who played every card.
+func (t *Tracker) TrickCompleted(trick cards.Cards, winningCard cards.Card, winnerId string) {
+	winner := t.seat(winnerId)
+	if winner < 0 {
+		return
+	}
+	leader := winner
+	for i, c := range trick {
+		if c == winningCard {
+			leader = (winner - i + 4) % 4
+		}
+	}
+	t.addVoids(leader, trick)
+	t.played = append(t.played, trick...)
+	t.points[winner] += trickScore(trick)
+	t.numTricks++
+}
+
+// Records what can be seen in the current trick when it's this player's turn.
+func (t *Tracker) ObserveTurn(gs client.GameState) {
+	if len(t.playerIds) == 0 {
+		t.StartHand(gs)
+	}
+	t.addVoids(currentTrickLeader(gs), gs.CurrentTrick)
+}
+
+func (t *Tracker) addVoids(leader int, trick cards.Cards) {
+	if len(trick) == 0 {
+		return
+	}
+	leadSuit := trick[0].Suit
+	for i, c := range trick {
+		if c.Suit != leadSuit {
+			t.voids[(leader+i)%4][leadSuit] = true
+		}
+	}
+}
+
+func (t *Tracker) seat(playerId string) int {
+	for i, id := range t.playerIds {
+		if id == playerId {
+			return i
+		}
+	}
+	return -1
+}
+
+// Number of completed tricks.
+func (t *Tracker) NumTricks() int {
+	return t.numTricks
+}
+
+// Cards played in completed tricks.
+func (t *Tracker) PlayedCards() cards.Cards {
+	return t.played.Copy()
+}
+
+func (t *Tracker) IsPlayed(c cards.Card) bool {
+	return t.played.ContainsCard(c)
+}
+
+// Reports whether the player has shown out of the suit.
+func (t *Tracker) IsVoid(playerId string, s cards.Suit) bool {
+	seat := t.seat(playerId)
+	return seat >= 0 && t.voids[seat][s]
+}
+
+// Points the player has taken in completed tricks.
+func (t *Tracker) PointsTaken(playerId string) int {
+	seat := t.seat(playerId)
+	if seat < 0 {
+		return 0
+	}
+	return t.points[seat]
+}
+
+// Cards still held by other players.
+func (t *Tracker) Outstanding(gs client.GameState) cards.Cards {
+	seen := cards.Combine(t.played, gs.CurrentTrick, gs.Players[0].Cards)
+	return cards.MakeDeck().Filter(func(c cards.Card) bool { return !seen.ContainsCard(c) })
+}
+
+// The Qs, Ks and As still held by other players.
+func (t *Tracker) HighSpadesOut(gs client.GameState) cards.Cards {
+	return t.Outstanding(gs).Filter(func(c cards.Card) bool {
+		return c.Suit == cards.Spades && c.Value >= cards.Queen
+	})
+}
+
+// Hearts still held by other players.
+func (t *Tracker) HeartsOut(gs client.GameState) cards.Cards {
+	return t.Outstanding(gs).FilterBySuit(cards.Hearts)
+}
+
+// What this player knows about the hidden cards, combining the Tracker with the GameState.
+func (t *Tracker) knowledge(gs client.GameState) knowledge {
+	k := newKnowledge(gs)
+	for seat := range k.voids {
+		for s := range k.voids[seat] {
+			k.voids[seat][s] = k.voids[seat][s] || t.voids[seat][s]
+		}
+	}
+	return k
+}
+
+func trickScore(cs cards.Cards) int {
+	score := 0
+	for _, c := range cs {
+		if c.Suit == cards.Hearts {
+			score++
+		} else if c == cards.Cqs {
+			score += 13
+		}
+	}
+	return score
+}
+
+// TrackerPlayer plays like the basic strategy, but uses a Tracker to avoid leading cards
+// that are sure to win the trick and to duck under the Qs when someone else has dropped it.
 
 func NewTrackerPlayer() client.GameCallbacks {
-	return &trackerPlayer{}
+	return newStrategyPlayer(newTrackerStrategy())
+}
+
+func newTrackerStrategy() PlayerStrategy {
+	return &trackerStrategy{}
+}
+
+type trackerStrategy struct {
+	tracker *Tracker
 }
 
-type trackerPlayer struct {
-	client.UnimplementedGameCallbacks
+func (s *trackerStrategy) SetTracker(t *Tracker) {
+	s.tracker = t
 }
 
-func (c trackerPlayer) HandleYourTurn(s client.Session, gameId string) error {
-	// TODO: Implement
-	ctx := context.Background()
-	gameState, err := s.GetGameState(ctx, gameId)
-	if err != nil {
-		return fmt.Errorf("couldn't get game state: %v", err)
+func (s *trackerStrategy) ChooseCardToPlay(gs client.GameState) cards.Card {
+	return s.ExplainCardToPlay(gs).Card
+}
+
+func (s *trackerStrategy) ExplainCardToPlay(gs client.GameState) Explanation {
+	legalPlays := gs.LegalPlays
+	if len(legalPlays) == 1 || s.tracker == nil {
+		return ExplainBasicStrategyCard(gs)
+	}
+	if e, ok := chooseMoonCard(gs); ok {
+		return e
+	}
+	trick := gs.CurrentTrick
+	if len(trick) == 0 {
+		return s.chooseLeadCard(gs)
 	}
-	for _, card := range gameState.Players[0].Cards {
-		err = s.PlayCard(ctx, gameId, card)
-		if err == nil {
-			break
+	// If the Qs has been dropped on a spade trick, get under the winning card if we can.
+	if trick[0].Suit == cards.Spades && trick.ContainsCard(cards.Cqs) && legalPlays.ContainsSuit(cards.Spades) {
+		leadingCard := trick.LeadingCardOfTrick()
+		return explain(legalPlays.HighestUnderValueOrLowest(leadingCard.Value), "duck-queen-trick",
+			"The %s is in this trick, so getting under the %s", cardName(cards.Cqs), cardName(leadingCard))
+	}
+	return ExplainBasicStrategyCard(gs)
+}
+
+// Leads what basic strategy would, unless nobody else holds a higher card of that suit.
+// Then leads the lowest card of the suit where the most higher cards are still out.
+func (s *trackerStrategy) chooseLeadCard(gs client.GameState) Explanation {
+	e := ExplainBasicStrategyCard(gs)
+	outstanding := s.tracker.Outstanding(gs)
+	if numHigher(outstanding, e.Card) > 0 {
+		return e
+	}
+	card, bestHigher := e.Card, 0
+	for _, c := range gs.LegalPlays {
+		if c == cards.Cqs {
+			continue
 		}
+		n := numHigher(outstanding, c)
+		if n > bestHigher || (n > 0 && n == bestHigher && c.Value < card.Value) {
+			card, bestHigher = c, n
+		}
+	}
+	if bestHigher == 0 {
+		return e
 	}
-	return nil
+	return explain(card, "avoid-boss-lead",
+		"Nobody else has a card above the %s, so leading the %s, which %d unplayed %s can beat",
+		cardName(e.Card), cardName(card), bestHigher, card.Suit.Name())
+}
+
+// Number of cards in cs that would beat c in a trick led with c.
+func numHigher(cs cards.Cards, c cards.Card) int {
+	return len(cs.Filter(func(o cards.Card) bool { return o.Suit == c.Suit && o.Value > c.Value }))
 }
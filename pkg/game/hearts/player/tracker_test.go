@@ -0,0 +1,66 @@
+package player
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+)
+
+func TestTrackerMatchesDeal(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		pos := &hearts.Position{FirstTrick: true}
+		for i, h := range cards.Deal(4) {
+			pos.Hands[i] = h
+			if h.ContainsCard(cards.C2c) {
+				pos.Leader = i
+			}
+		}
+		var trackers [4]*Tracker
+		var tricks [4][]cards.Cards
+		for seat := range trackers {
+			trackers[seat] = NewTracker()
+			trackers[seat].StartHand(GameStateForSeat(pos, tricks, seat))
+		}
+		for !pos.IsOver() {
+			me := pos.NextSeat()
+			gs := GameStateForSeat(pos, tricks, me)
+			tr := trackers[me]
+			tr.ObserveTurn(gs)
+			var others cards.Cards
+			for seat := 1; seat < 4; seat++ {
+				hand := pos.Hands[(me+seat)%4]
+				others = append(others, hand...)
+				for _, s := range cards.Suits {
+					if tr.IsVoid(gs.Players[seat].Id, s) && hand.ContainsSuit(s) {
+						t.Fatalf("seat %d holds %s but was tracked as void: %v", seat, s, gs)
+					}
+				}
+			}
+			others.Sort()
+			if out := tr.Outstanding(gs); !out.Equals(others) {
+				t.Fatalf("Outstanding()=%s, want %s", out, others)
+			}
+			for seat, p := range gs.Players {
+				if got := tr.PointsTaken(p.Id); got != p.TrickScore {
+					t.Fatalf("PointsTaken(seat %d)=%d, want %d", seat, got, p.TrickScore)
+				}
+			}
+			card := newTrackerStrategy().ChooseCardToPlay(gs)
+			trick := append(pos.Trick.Copy(), card)
+			pos.Play(card)
+			if len(trick) == 4 {
+				tricks[pos.Leader] = append(tricks[pos.Leader], trick)
+				for _, tr := range trackers {
+					tr.TrickCompleted(trick, trick.LeadingCardOfTrick(), strconv.Itoa(pos.Leader))
+				}
+			}
+		}
+		for _, tr := range trackers {
+			if tr.NumTricks() != 13 || len(tr.PlayedCards()) != 52 {
+				t.Fatalf("tracked %d tricks and %d cards, want 13 and 52", tr.NumTricks(), len(tr.PlayedCards()))
+			}
+		}
+	}
+}
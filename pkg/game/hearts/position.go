@@ -0,0 +1,184 @@
+package hearts
+
+import (
+	"fmt"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+// Position is a point in a hand of hearts where every player's remaining cards are known.
+// It is used to simulate and search the rest of the hand.
+// Seats are numbered 0-3 in turn order.
+type Position struct {
+	Hands        [4]cards.Cards // Remaining cards by seat.
+	Trick        cards.Cards    // Cards played to the current trick, in order.
+	Leader       int            // Seat that led the current trick.
+	Points       [4]int         // Points taken so far by seat.
+	HeartsBroken bool
+	FirstTrick   bool // True until the first trick is completed.
+}
+
+// Returns the position in a recorded deal after its first n plays.
+// Seats 0-3 are North, East, South and West.
+func PositionFromDeal(d cards.DealRecord, n int) (*Position, error) {
+	pos := &Position{FirstTrick: true}
+	for i, h := range d.Hands {
+		pos.Hands[i] = h.Copy()
+		if h.ContainsCard(cards.C2c) {
+			pos.Leader = i
+		}
+	}
+	played := 0
+	for _, t := range d.Tricks {
+		for _, c := range t.Cards {
+			if played == n {
+				return pos, nil
+			}
+			if !pos.LegalPlays().ContainsCard(c) {
+				return nil, fmt.Errorf("board %d: %s can't play %s", d.Board, cards.Seat(pos.NextSeat()).Name(), c)
+			}
+			pos.Play(c)
+			played++
+		}
+	}
+	if played < n {
+		return nil, fmt.Errorf("board %d has only %d plays", d.Board, played)
+	}
+	return pos, nil
+}
+
+// Returns a copy that can be played forward without affecting this one.
+func (p *Position) Clone() *Position {
+	c := *p
+	for i, h := range p.Hands {
+		c.Hands[i] = h.Copy()
+	}
+	c.Trick = p.Trick.Copy()
+	return &c
+}
+
+// Seat whose turn it is.
+func (p *Position) NextSeat() int {
+	return (p.Leader + len(p.Trick)) % 4
+}
+
+// Returns the seat that played the i'th card of the current trick.
+func (p *Position) TrickSeat(i int) int {
+	return (p.Leader + i) % 4
+}
+
+func (p *Position) IsOver() bool {
+	return len(p.Trick) == 0 && len(p.Hands[p.Leader]) == 0
+}
+
+// Number of tricks still to be completed, including the current one.
+func (p *Position) TricksLeft() int {
+	if len(p.Trick) > 0 {
+		return len(p.Hands[p.Leader]) + 1
+	}
+	return len(p.Hands[p.Leader])
+}
+
+func (p *Position) LegalPlays() cards.Cards {
+	hand := p.Hands[p.NextSeat()]
+	var cs cards.Cards
+	for _, c := range hand {
+		if isValidCardForTrick(c, p.Trick, hand, p.FirstTrick, p.HeartsBroken) {
+			cs = append(cs, c)
+		}
+	}
+	if len(cs) == 0 {
+		// Only possible on the first trick with nothing but hearts and the Qs.
+		return hand.Copy()
+	}
+	return cs
+}
+
+// Plays a card for the next seat, completing the trick if it's the fourth card.
+// The card is assumed to be legal.
+func (p *Position) Play(c cards.Card) {
+	seat := p.NextSeat()
+	p.Hands[seat] = p.Hands[seat].Remove(c)
+	p.Trick = append(p.Trick, c)
+	if c.Suit == cards.Hearts {
+		p.HeartsBroken = true
+	}
+	if len(p.Trick) < 4 {
+		return
+	}
+	winner := p.trickWinner()
+	p.Points[winner] += trickScore(p.Trick)
+	p.Leader = winner
+	p.Trick = nil
+	p.FirstTrick = false
+}
+
+// Seat currently winning the trick.
+func (p *Position) trickWinner() int {
+	winningCard := p.Trick.LeadingCardOfTrick()
+	for i, c := range p.Trick {
+		if c == winningCard {
+			return p.TrickSeat(i)
+		}
+	}
+	return p.Leader
+}
+
+// Scores for the hand, once it's over. Shooting the moon gives everyone else 26.
+func (p *Position) HandScores() [4]int {
+	for shooter, pts := range p.Points {
+		if pts == 26 {
+			var scores [4]int
+			for i := range scores {
+				if i != shooter {
+					scores[i] = 26
+				}
+			}
+			return scores
+		}
+	}
+	return p.Points
+}
+
+// Plays out the rest of the hand with RolloutCard for every seat.
+func (p *Position) PlayOut() {
+	for !p.IsOver() {
+		p.Play(p.RolloutCard())
+	}
+}
+
+// Chooses a card for the next seat with a quick rule of thumb, for use in simulations:
+// lead low, duck under the winning card when possible, and dump the Qs or high hearts when void.
+func (p *Position) RolloutCard() cards.Card {
+	legalPlays := p.LegalPlays()
+	if len(legalPlays) == 1 {
+		return legalPlays[0]
+	}
+	if len(p.Trick) == 0 {
+		nonHearts := legalPlays.Filter(func(c cards.Card) bool { return c.Suit != cards.Hearts && c != cards.Cqs })
+		if len(nonHearts) > 0 {
+			return nonHearts.Lowest()
+		}
+		return legalPlays.Lowest()
+	}
+	leadSuit := p.Trick[0].Suit
+	if legalPlays.ContainsSuit(leadSuit) {
+		leadingCard := p.Trick.LeadingCardOfTrick()
+		if len(p.Trick) == 3 && trickScore(p.Trick) == 0 {
+			// Safe to take it, so get rid of a high card (but not the Qs).
+			safe := legalPlays.Filter(func(c cards.Card) bool { return c != cards.Cqs })
+			if len(safe) > 0 {
+				return safe.Highest()
+			}
+		}
+		return legalPlays.HighestUnderValueOrLowest(leadingCard.Value)
+	}
+	if legalPlays.ContainsCard(cards.Cqs) {
+		return cards.Cqs
+	}
+	hearts := legalPlays.FilterBySuit(cards.Hearts)
+	if len(hearts) > 0 {
+		return hearts.Highest()
+	}
+	return legalPlays.Highest()
+}
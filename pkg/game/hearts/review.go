@@ -0,0 +1,55 @@
+package hearts
+
+import (
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+// A play that cost points compared with the best play, found by ReviewEndgame.
+type Mistake struct {
+	Trick  int // Counting from 1.
+	Seat   cards.Seat
+	Played PlayValue
+	Best   PlayValue
+}
+
+// Checks each play in the last endgameTricks tricks of a recorded deal against the solver.
+// A play is a mistake if the player could have guaranteed a lower score with another card.
+func ReviewEndgame(d cards.DealRecord, endgameTricks int) ([]Mistake, error) {
+	solver := NewSolver()
+	var mistakes []Mistake
+	n := 0
+	for i, t := range d.Tricks {
+		for _, c := range t.Cards {
+			if 13-i <= endgameTricks {
+				pos, err := PositionFromDeal(d, n)
+				if err != nil {
+					return nil, err
+				}
+				values, err := solver.EvaluatePlays(pos)
+				if err != nil {
+					return nil, err
+				}
+				var played, best PlayValue
+				best = values[0]
+				for _, v := range values {
+					if v.Card == c {
+						played = v
+					}
+					if v.Score < best.Score {
+						best = v
+					}
+				}
+				if played.Score > best.Score {
+					mistakes = append(mistakes, Mistake{
+						Trick:  i + 1,
+						Seat:   cards.Seat(pos.NextSeat()),
+						Played: played,
+						Best:   best,
+					})
+				}
+			}
+			n++
+		}
+	}
+	return mistakes, nil
+}
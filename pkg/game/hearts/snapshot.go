@@ -0,0 +1,146 @@
+package hearts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/game"
+)
+
+// The saved form of a heartsGame.
+type savedGame struct {
+	Id               string
+	LastActivityTime time.Time
+	Phase            game.GamePhase
+	Players          []savedPlayer // In turn order.
+	NumTricksPlayed  int
+	CurrentTrick     []string
+	CurrentTrickIds  []string // Player who played each card of the current trick.
+	NextPlayerIndex  int
+	HeartsBroken     bool
+	PresetHands      [][]string `json:",omitempty"`
+}
+
+type savedPlayer struct {
+	Id             string
+	Name           string
+	IsBot          bool
+	Seat           string // Seat letter, e.g. N.
+	IsReadyToStart bool
+	Cards          []string
+	Tricks         [][]string
+	TrickScore     int
+	HandScore      int
+}
+
+func (g *heartsGame) Snapshot() ([]byte, error) {
+	sg := savedGame{
+		Id:               g.id,
+		LastActivityTime: g.lastActivityTime,
+		Phase:            g.phase,
+		NumTricksPlayed:  g.numTricksPlayed,
+		CurrentTrick:     g.currentTrick.cards.Strings(),
+		CurrentTrickIds:  g.currentTrick.playerIds,
+		NextPlayerIndex:  g.nextPlayerIndex,
+		HeartsBroken:     g.heartsBroken,
+		PresetHands:      allStrings(g.presetHands),
+	}
+	for _, id := range g.playerOrder {
+		p := g.players[id]
+		sg.Players = append(sg.Players, savedPlayer{
+			Id:             p.id,
+			Name:           p.name,
+			IsBot:          p.isBot,
+			Seat:           p.seat.String(),
+			IsReadyToStart: p.isReadyToStart,
+			Cards:          p.cards.Strings(),
+			Tricks:         allStrings(p.tricks),
+			TrickScore:     p.trickScore,
+			HandScore:      p.handScore,
+		})
+	}
+	return json.Marshal(sg)
+}
+
+// Recreates a game from its Snapshot.
+func RestoreGame(data []byte) (game.Game, error) {
+	var sg savedGame
+	if err := json.Unmarshal(data, &sg); err != nil {
+		return nil, err
+	}
+	g := NewGame(sg.Id).(*heartsGame)
+	g.lastActivityTime = sg.LastActivityTime
+	g.phase = sg.Phase
+	g.numTricksPlayed = sg.NumTricksPlayed
+	g.nextPlayerIndex = sg.NextPlayerIndex
+	g.heartsBroken = sg.HeartsBroken
+	var err error
+	if g.presetHands, err = parseAll(sg.PresetHands); err != nil {
+		return nil, err
+	}
+	for _, sp := range sg.Players {
+		if sp.Seat == "" {
+			return nil, fmt.Errorf("player %s has no seat", sp.Id)
+		}
+		p := &player{
+			id:             sp.Id,
+			name:           sp.Name,
+			isBot:          sp.IsBot,
+			isReadyToStart: sp.IsReadyToStart,
+			trickScore:     sp.TrickScore,
+			handScore:      sp.HandScore,
+		}
+		if p.seat, err = cards.ParseSeat(sp.Seat); err != nil {
+			return nil, err
+		}
+		if g.playerIn(p.seat) != nil {
+			return nil, fmt.Errorf("two players are sitting %s", p.seat.Name())
+		}
+		if p.cards, err = cards.ParseCards(sp.Cards); err != nil {
+			return nil, err
+		}
+		if p.tricks, err = parseAll(sp.Tricks); err != nil {
+			return nil, err
+		}
+		g.players[p.id] = p
+		g.playerOrder = append(g.playerOrder, p.id)
+	}
+	if g.currentTrick.cards, err = cards.ParseCards(sg.CurrentTrick); err != nil {
+		return nil, err
+	}
+	g.currentTrick.playerIds = sg.CurrentTrickIds
+	if len(g.currentTrick.cards) != len(g.currentTrick.playerIds) {
+		return nil, fmt.Errorf("current trick has %d cards played by %d players", len(g.currentTrick.cards), len(g.currentTrick.playerIds))
+	}
+	for _, id := range g.currentTrick.playerIds {
+		if !g.HasPlayer(id) {
+			return nil, fmt.Errorf("current trick was played by unknown player %s", id)
+		}
+	}
+	if g.phase != game.Preparing && (len(g.playerOrder) != 4 || g.nextPlayerIndex < 0 || g.nextPlayerIndex >= 4) {
+		return nil, fmt.Errorf("game with %d players can't have next player %d", len(g.playerOrder), g.nextPlayerIndex)
+	}
+	return g, nil
+}
+
+func allStrings(css []cards.Cards) [][]string {
+	var ss [][]string
+	for _, cs := range css {
+		ss = append(ss, cs.Strings())
+	}
+	return ss
+}
+
+func parseAll(ss [][]string) ([]cards.Cards, error) {
+	var css []cards.Cards
+	for _, s := range ss {
+		cs, err := cards.ParseCards(s)
+		if err != nil {
+			return nil, err
+		}
+		css = append(css, cs)
+	}
+	return css, nil
+}
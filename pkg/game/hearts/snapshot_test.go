@@ -0,0 +1,99 @@
+package hearts
+
+import (
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/game"
+	"google.golang.org/protobuf/proto"
+)
+
+type nopReporter struct{}
+
+func (nopReporter) ReportPlayerJoined(game.Game, string)                                    {}
+func (nopReporter) ReportPlayerLeft(game.Game, string)                                      {}
+func (nopReporter) ReportGameStarted(game.Game)                                             {}
+func (nopReporter) ReportCardPlayed(game.Game, cards.Card, string, string)                  {}
+func (nopReporter) ReportTrickCompleted(game.Game, cards.Cards, cards.Card, string, string) {}
+func (nopReporter) ReportGameFinished(game.Game)                                            {}
+func (nopReporter) ReportGameAborted(game.Game)                                             {}
+func (nopReporter) ReportNextTurn(game.Game)                                                {}
+func (nopReporter) BroadcastMessage(game.Game, string)                                      {}
+
+// Plays the first legal card n times.
+func playFirstLegalCards(t *testing.T, g game.Game, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		id := g.NextPlayerId()
+		gs, err := g.GetGameState(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		card, err := cards.ParseCard(gs.GetLegalPlays().GetCards()[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.HandlePlayCard(id, card, nopReporter{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSnapshotRestoresGame(t *testing.T) {
+	g := NewGame("g0001")
+	ids := []string{"p0001", "p0002", "p0003", "p0004"}
+	for i, id := range ids {
+		g.AddPlayer("player"+id, id, i == 2)
+	}
+	g.StartGame()
+	// Partway through the sixth trick, with hearts likely broken.
+	playFirstLegalCards(t, g, 22)
+
+	data, err := g.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := RestoreGame(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Id() != g.Id() || restored.Phase() != g.Phase() || restored.NextPlayerId() != g.NextPlayerId() {
+		t.Fatalf("restored game %s is %v with %s next, want %s %v with %s next",
+			restored.Id(), restored.Phase(), restored.NextPlayerId(), g.Id(), g.Phase(), g.NextPlayerId())
+	}
+	compareStates := func() {
+		t.Helper()
+		for _, id := range ids {
+			want, _ := g.GetGameState(id)
+			got, _ := restored.GetGameState(id)
+			if !proto.Equal(got, want) {
+				t.Fatalf("restored game state for %s is\n%v\nwant\n%v", id, got, want)
+			}
+		}
+	}
+	compareStates()
+
+	// Both games play out the same way.
+	playFirstLegalCards(t, g, 30)
+	playFirstLegalCards(t, restored, 30)
+	if restored.Phase() != game.Completed {
+		t.Fatalf("restored game is %v after playing every card, want Completed", restored.Phase())
+	}
+	compareStates()
+}
+
+func TestRestoreGameRejectsBadSnapshot(t *testing.T) {
+	for _, data := range []string{
+		`not json`,
+		`{"Id":"g1","Phase":1,"Players":[{"Id":"p1","Seat":"N","Cards":["2c"]}]}`,
+		`{"Id":"g1","Phase":0,"CurrentTrick":["2c"],"CurrentTrickIds":["p1"]}`,
+		`{"Id":"g1","Phase":0,"Players":[{"Id":"p1","Seat":"N","Cards":["zz"]}]}`,
+		`{"Id":"g1","Phase":0,"Players":[{"Id":"p1"}]}`,
+		`{"Id":"g1","Phase":0,"Players":[{"Id":"p1","Seat":"X"}]}`,
+		`{"Id":"g1","Phase":0,"Players":[{"Id":"p1","Seat":"N"},{"Id":"p2","Seat":"N"}]}`,
+	} {
+		if _, err := RestoreGame([]byte(data)); err == nil {
+			t.Errorf("RestoreGame(%s) succeeded, want an error", data)
+		}
+	}
+}
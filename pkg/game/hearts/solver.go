@@ -0,0 +1,385 @@
+package hearts
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+// Solver finds the best play for positions where every card is known ("double dummy").
+//
+// Hearts has four players with separate scores, so each search is paranoid: it finds
+// the lowest hand score a seat can guarantee when the other three play to give it as
+// many points as possible. Searches use alpha-beta pruning, a transposition table of
+// positions at trick boundaries, and treat touching cards in a hand as one play.
+type Solver struct {
+	// Searches give up with ErrSearchLimit after this many positions. 0 means no limit.
+	MaxNodes int
+
+	nodes int
+	table map[solverKey]solverEntry
+}
+
+var ErrSearchLimit = errors.New("solver search limit reached")
+
+func NewSolver() *Solver {
+	return &Solver{table: make(map[solverKey]solverEntry)}
+}
+
+// The score a seat can guarantee after playing a card.
+type PlayValue struct {
+	Card  cards.Card
+	Score int
+}
+
+// Returns the lowest hand score each seat can guarantee from this position.
+func (s *Solver) Solve(pos *Position) ([4]int, error) {
+	var scores [4]int
+	for seat := range scores {
+		score, err := s.SolveFor(pos, seat)
+		if err != nil {
+			return scores, err
+		}
+		scores[seat] = score
+	}
+	return scores, nil
+}
+
+// Returns the lowest hand score the seat can guarantee from this position.
+func (s *Solver) SolveFor(pos *Position, seat int) (int, error) {
+	s.nodes = 0
+	st := newSolverState(pos)
+	return s.search(&st, seat, 0, 27)
+}
+
+// Returns every legal play for the seat to move, with the score it can guarantee after making it.
+// Plays are in the same order as pos.LegalPlays().
+func (s *Solver) EvaluatePlays(pos *Position) ([]PlayValue, error) {
+	s.nodes = 0
+	seat := pos.NextSeat()
+	var values []PlayValue
+	for _, c := range pos.LegalPlays() {
+		st := newSolverState(pos)
+		st.play(cardIndex(c))
+		score, err := s.search(&st, seat, 0, 27)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, PlayValue{Card: c, Score: score})
+	}
+	return values, nil
+}
+
+// Returns a legal play for the seat to move with the lowest guaranteed score.
+func (s *Solver) BestPlay(pos *Position) (PlayValue, error) {
+	values, err := s.EvaluatePlays(pos)
+	if err != nil {
+		return PlayValue{}, err
+	}
+	best := values[0]
+	for _, v := range values {
+		if v.Score < best.Score {
+			best = v
+		}
+	}
+	return best, nil
+}
+
+// Cards are bits in a uint64, 13 per suit, ordered by value within each suit.
+func cardIndex(c cards.Card) int {
+	return int(c.Suit)*13 + int(c.Value)
+}
+func indexCard(i int) cards.Card {
+	return cards.Card{Value: cards.Value(i % 13), Suit: cards.Suit(i / 13)}
+}
+func toMask(cs cards.Cards) uint64 {
+	var m uint64
+	for _, c := range cs {
+		m |= 1 << cardIndex(c)
+	}
+	return m
+}
+
+const (
+	clubsMask  = uint64(1)<<13 - 1
+	heartsMask = clubsMask << 13
+	qsIndex    = 2*13 + int(cards.Queen)
+	c2Index    = 0
+)
+
+func suitMask(suit int) uint64 {
+	return clubsMask << (13 * suit)
+}
+
+// A compact, mutable copy of a Position for searching.
+type solverState struct {
+	hands        [4]uint64
+	trick        [4]int8 // card indexes
+	trickLen     int8
+	leader       int8
+	points       [4]int8
+	heartsBroken bool
+	firstTrick   bool
+}
+
+func newSolverState(pos *Position) solverState {
+	st := solverState{
+		leader:       int8(pos.Leader),
+		heartsBroken: pos.HeartsBroken,
+		firstTrick:   pos.FirstTrick,
+	}
+	for i, h := range pos.Hands {
+		st.hands[i] = toMask(h)
+		st.points[i] = int8(pos.Points[i])
+	}
+	for _, c := range pos.Trick {
+		st.trick[st.trickLen] = int8(cardIndex(c))
+		st.trickLen++
+	}
+	return st
+}
+
+func (st *solverState) nextSeat() int {
+	return int(st.leader+st.trickLen) % 4
+}
+
+// Legal plays for the next seat, following the same rules as isValidCardForTrick.
+func (st *solverState) legalPlays() uint64 {
+	hand := st.hands[st.nextSeat()]
+	if st.trickLen == 0 {
+		if st.firstTrick {
+			return hand & (1 << c2Index)
+		}
+		if !st.heartsBroken && hand&^heartsMask != 0 {
+			return hand &^ heartsMask
+		}
+		return hand
+	}
+	plays := hand
+	if follow := hand & suitMask(int(st.trick[0])/13); follow != 0 {
+		plays = follow
+	}
+	if st.firstTrick {
+		safe := plays &^ (1 << qsIndex)
+		if hand&^heartsMask != 0 {
+			safe &^= heartsMask
+		}
+		if safe != 0 {
+			return safe
+		}
+	}
+	return plays
+}
+
+type undoInfo struct {
+	trick        [4]int8
+	trickLen     int8
+	leader       int8
+	points       [4]int8
+	heartsBroken bool
+	firstTrick   bool
+}
+
+func (st *solverState) play(ci int) undoInfo {
+	u := undoInfo{st.trick, st.trickLen, st.leader, st.points, st.heartsBroken, st.firstTrick}
+	st.hands[st.nextSeat()] &^= 1 << ci
+	st.trick[st.trickLen] = int8(ci)
+	st.trickLen++
+	if ci/13 == int(cards.Hearts) {
+		st.heartsBroken = true
+	}
+	if st.trickLen == 4 {
+		leadSuit := st.trick[0] / 13
+		winner := 0
+		pts := int8(0)
+		for i, c := range st.trick {
+			if c/13 == leadSuit && c > st.trick[winner] {
+				winner = i
+			}
+			if c/13 == int8(cards.Hearts) {
+				pts++
+			} else if int(c) == qsIndex {
+				pts += 13
+			}
+		}
+		st.leader = (st.leader + int8(winner)) % 4
+		st.points[st.leader] += pts
+		st.trickLen = 0
+		st.firstTrick = false
+	}
+	return u
+}
+
+func (st *solverState) undo(ci int, u undoInfo) {
+	st.trick, st.trickLen, st.leader, st.points, st.heartsBroken, st.firstTrick =
+		u.trick, u.trickLen, u.leader, u.points, u.heartsBroken, u.firstTrick
+	st.hands[st.nextSeat()] |= 1 << ci
+}
+
+func (st *solverState) isOver() bool {
+	return st.trickLen == 0 && st.hands[st.leader] == 0
+}
+
+func (st *solverState) handScore(seat int) int {
+	for shooter, pts := range st.points {
+		if pts == 26 {
+			if shooter == seat {
+				return 0
+			}
+			return 26
+		}
+	}
+	return int(st.points[seat])
+}
+
+// The transposition table is cleared when it reaches this many entries.
+const maxTableSize = 1 << 20
+
+type solverKey struct {
+	hands [4]uint64
+	other uint64 // leader, points, perspective seat and flags
+}
+
+type solverEntry struct {
+	value int8
+	bound int8 // exact, lower or upper
+}
+
+const (
+	exactBound int8 = iota
+	lowerBound
+	upperBound
+)
+
+func (st *solverState) key(seat int) solverKey {
+	other := uint64(st.leader) | uint64(seat)<<2
+	for i, p := range st.points {
+		other |= uint64(p) << (4 + 5*i)
+	}
+	if st.heartsBroken {
+		other |= 1 << 24
+	}
+	return solverKey{st.hands, other}
+}
+
+// Alpha-beta search for the seat's hand score, which it minimizes and everyone else maximizes.
+func (s *Solver) search(st *solverState, seat int, alpha, beta int) (int, error) {
+	if st.isOver() {
+		return st.handScore(seat), nil
+	}
+	s.nodes++
+	if s.MaxNodes > 0 && s.nodes > s.MaxNodes {
+		return 0, ErrSearchLimit
+	}
+	atTrickStart := st.trickLen == 0 && !st.firstTrick
+	var key solverKey
+	if atTrickStart {
+		key = st.key(seat)
+		if e, found := s.table[key]; found {
+			v := int(e.value)
+			switch {
+			case e.bound == exactBound,
+				e.bound == lowerBound && v >= beta,
+				e.bound == upperBound && v <= alpha:
+				return v, nil
+			}
+		}
+	}
+	origAlpha, origBeta := alpha, beta
+	minimizing := st.nextSeat() == seat
+	best := 27
+	if !minimizing {
+		best = -1
+	}
+	for _, ci := range st.orderedPlays(seat) {
+		u := st.play(ci)
+		v, err := s.search(st, seat, alpha, beta)
+		st.undo(ci, u)
+		if err != nil {
+			return 0, err
+		}
+		if minimizing {
+			if v < best {
+				best = v
+			}
+			if best < beta {
+				beta = best
+			}
+		} else {
+			if v > best {
+				best = v
+			}
+			if best > alpha {
+				alpha = best
+			}
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	if atTrickStart {
+		bound := exactBound
+		if best <= origAlpha {
+			bound = upperBound
+		} else if best >= origBeta {
+			bound = lowerBound
+		}
+		if len(s.table) >= maxTableSize {
+			s.table = make(map[solverKey]solverEntry)
+		}
+		s.table[key] = solverEntry{value: int8(best), bound: bound}
+	}
+	return best, nil
+}
+
+// Legal plays with equivalent cards removed, in the order most likely to cause a cutoff.
+func (st *solverState) orderedPlays(seat int) []int {
+	plays := st.legalPlays()
+	mover := st.nextSeat()
+	// Cards still in play: a card is equivalent to the next higher card in the same hand
+	// if nothing still in play lies between them. The Qs and hearts carry different
+	// points, so hearts only merge with hearts and the Qs never merges.
+	var inPlay uint64
+	for _, h := range st.hands {
+		inPlay |= h
+	}
+	for i := int8(0); i < st.trickLen; i++ {
+		inPlay |= 1 << st.trick[i]
+	}
+	var reps []int
+	for m := plays; m != 0; m &= m - 1 {
+		ci := bits.TrailingZeros64(m)
+		next := ci + 1
+		for next%13 != 0 && inPlay&(1<<next) == 0 {
+			next++
+		}
+		if next%13 != 0 && plays&(1<<next) != 0 && ci != qsIndex && next != qsIndex {
+			// The next card up is equivalent; let it represent both.
+			continue
+		}
+		reps = append(reps, ci)
+	}
+	// Move ordering: the searching seat tries to shed danger first; opponents try to hand it points.
+	score := func(ci int) int {
+		isPoint := ci == qsIndex || ci/13 == int(cards.Hearts)
+		if st.trickLen > 0 && ci/13 != int(st.trick[0])/13 {
+			// Discard: points and high cards first.
+			if isPoint {
+				return 100 + ci%13
+			}
+			return ci % 13
+		}
+		if mover == seat {
+			// Lower cards are less likely to win the trick.
+			return 20 - ci%13
+		}
+		return ci % 13
+	}
+	for i := 1; i < len(reps); i++ {
+		for j := i; j > 0 && score(reps[j]) > score(reps[j-1]); j-- {
+			reps[j], reps[j-1] = reps[j-1], reps[j]
+		}
+	}
+	return reps
+}
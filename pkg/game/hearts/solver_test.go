@@ -0,0 +1,130 @@
+package hearts
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+)
+
+// Deals at random and plays random legal cards until the given number of cards remain in total.
+func randomPosition(rng *rand.Rand, cardsLeft int) *Position {
+	deck := cards.MakeDeck()
+	rng.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+	pos := &Position{FirstTrick: true}
+	for i, c := range deck {
+		pos.Hands[i%4] = append(pos.Hands[i%4], c)
+		if c == cards.C2c {
+			pos.Leader = i % 4
+		}
+	}
+	for n := 52; n > cardsLeft; n-- {
+		plays := pos.LegalPlays()
+		pos.Play(plays[rng.Intn(len(plays))])
+	}
+	return pos
+}
+
+// Exhaustive paranoid minimax, for checking the solver.
+func bruteForce(pos *Position, seat int) int {
+	if pos.IsOver() {
+		return pos.HandScores()[seat]
+	}
+	minimizing := pos.NextSeat() == seat
+	best := -1
+	if minimizing {
+		best = 27
+	}
+	for _, c := range pos.LegalPlays() {
+		p := pos.Clone()
+		p.Play(c)
+		v := bruteForce(p, seat)
+		if minimizing && v < best || !minimizing && v > best {
+			best = v
+		}
+	}
+	return best
+}
+
+func TestSolverMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s := NewSolver()
+	for i := 0; i < 200; i++ {
+		pos := randomPosition(rng, 9+rng.Intn(4))
+		for seat := 0; seat < 4; seat++ {
+			want := bruteForce(pos, seat)
+			got, err := s.SolveFor(pos, seat)
+			if err != nil {
+				t.Fatalf("SolveFor()=%v", err)
+			}
+			if got != want {
+				t.Fatalf("SolveFor(%+v, %d)=%d, want %d", pos, seat, got, want)
+			}
+		}
+	}
+}
+
+func TestSolverEvaluatePlays(t *testing.T) {
+	// Seat 0 leads holding the Qs and the 2s, and everyone else has one low spade and a club.
+	// Leading the 2s lets seat 0 discard the Qs on the club trick; leading the Qs wins it.
+	pos := &Position{
+		Hands: [4]cards.Cards{
+			{cards.C2s, cards.Cqs},
+			{cards.C3s, cards.C3c},
+			{cards.C4s, cards.C4c},
+			{cards.C5s, cards.C5c},
+		},
+		HeartsBroken: true,
+	}
+	values, err := NewSolver().EvaluatePlays(pos)
+	if err != nil {
+		t.Fatalf("EvaluatePlays()=%v", err)
+	}
+	want := map[cards.Card]int{cards.C2s: 0, cards.Cqs: 13}
+	if len(values) != len(want) {
+		t.Fatalf("EvaluatePlays()=%v, want %v", values, want)
+	}
+	for _, v := range values {
+		if want[v.Card] != v.Score {
+			t.Errorf("EvaluatePlays() %s=%d, want %d", v.Card, v.Score, want[v.Card])
+		}
+	}
+}
+
+func TestSolverSearchLimit(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	s := NewSolver()
+	s.MaxNodes = 10
+	if _, err := s.SolveFor(randomPosition(rng, 40), 0); err != ErrSearchLimit {
+		t.Errorf("SolveFor()=%v, want ErrSearchLimit", err)
+	}
+}
+
+func TestReviewEndgame(t *testing.T) {
+	d := cards.NewRandomDeal(1)
+	pos, err := PositionFromDeal(d, 0)
+	if err != nil {
+		t.Fatalf("PositionFromDeal()=%v", err)
+	}
+	// Record a hand played by the rollout policy.
+	for !pos.IsOver() {
+		if len(pos.Trick) == 0 {
+			d.Tricks = append(d.Tricks, cards.PlayedTrick{Leader: cards.Seat(pos.Leader)})
+		}
+		c := pos.RolloutCard()
+		d.Tricks[len(d.Tricks)-1].Cards = append(d.Tricks[len(d.Tricks)-1].Cards, c)
+		pos.Play(c)
+	}
+	if err := d.Validate(); err != nil {
+		t.Fatalf("recorded deal is invalid: %v", err)
+	}
+	mistakes, err := ReviewEndgame(d, 3)
+	if err != nil {
+		t.Fatalf("ReviewEndgame()=%v", err)
+	}
+	for _, m := range mistakes {
+		if m.Trick < 11 || m.Played.Score <= m.Best.Score {
+			t.Errorf("ReviewEndgame() reported %+v", m)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Package suite checks hearts strategies against a suite of positions with known good plays,
+// so that changes to a strategy can be checked for regressions.
+//
+// A suite file holds positions separated by blank lines. Lines starting with # are comments.
+//
+//	position lead-low-nonspade
+//	hand 3c 8c 4d jd 5h ks 3s 7s 9d 6h 2h qd
+//	taken left 2c ac 5c tc
+//	accept 3c
+//	rule lead-low-nonspade
+//
+// hand is your cards. Each taken line is a trick already won by a seat (me, left, across or
+// right), in order of play; there is one per trick played so far. trick is the cards played
+// to the current trick, in order, by the players before you, and is left out when you lead.
+// accept is every play that passes. rule is optional and names the rule basic strategy is
+// expected to use, to show which of its branches the position covers.
+package suite
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
+)
+
+// The suite used when no suite file is given.
+//
+//go:embed basic.positions
+var defaultSuite string
+
+// Seat names in a suite file, in turn order starting with the player to move.
+var seatNames = []string{"me", "left", "across", "right"}
+
+type Position struct {
+	Name   string
+	Hand   cards.Cards
+	Taken  [4][]cards.Cards // Tricks taken so far by each seat, starting with me.
+	Trick  cards.Cards
+	Accept cards.Cards
+	Rule   string // Basic strategy's expected rule, if given.
+}
+
+// Reads a suite from a file, or the default suite if path is "".
+func ReadSuiteFile(path string) ([]Position, error) {
+	if path == "" {
+		return ReadSuite(strings.NewReader(defaultSuite))
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadSuite(f)
+}
+
+func ReadSuite(r io.Reader) ([]Position, error) {
+	var positions []Position
+	var p *Position
+	finish := func() error {
+		if p == nil {
+			return nil
+		}
+		if _, err := p.GameState(); err != nil {
+			return fmt.Errorf("position %s: %w", p.Name, err)
+		}
+		positions = append(positions, *p)
+		p = nil
+		return nil
+	}
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line == "" {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		key, value, _ := strings.Cut(line, " ")
+		value = strings.TrimSpace(value)
+		if key == "position" {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			p = &Position{Name: value}
+			continue
+		}
+		if p == nil {
+			return nil, fmt.Errorf("line %d: %s before the first position", lineNum, key)
+		}
+		var err error
+		switch key {
+		case "hand":
+			p.Hand, err = parseCards(value)
+		case "trick":
+			p.Trick, err = parseCards(value)
+		case "accept":
+			p.Accept, err = parseCards(value)
+		case "rule":
+			p.Rule = value
+		case "taken":
+			seatName, trickCards, _ := strings.Cut(value, " ")
+			seat := seatIndex(seatName)
+			if seat < 0 {
+				return nil, fmt.Errorf("line %d: unknown seat %s, must be one of %v", lineNum, seatName, seatNames)
+			}
+			var trick cards.Cards
+			if trick, err = parseCards(trickCards); err == nil && len(trick) != 4 {
+				err = fmt.Errorf("a trick has 4 cards, not %d", len(trick))
+			}
+			p.Taken[seat] = append(p.Taken[seat], trick)
+		default:
+			err = fmt.Errorf("unknown key %s", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+func parseCards(s string) (cards.Cards, error) {
+	return cards.ParseCards(strings.Fields(s))
+}
+
+func seatIndex(name string) int {
+	for i, n := range seatNames {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Returns the position as the player to move would see it.
+func (p Position) GameState() (client.GameState, error) {
+	numTaken := 0
+	seen := map[cards.Card]bool{}
+	addSeen := func(cs cards.Cards) error {
+		for _, c := range cs {
+			if seen[c] {
+				return fmt.Errorf("%s appears more than once", c)
+			}
+			seen[c] = true
+		}
+		return nil
+	}
+	for _, tricks := range p.Taken {
+		for _, t := range tricks {
+			numTaken++
+			if err := addSeen(t); err != nil {
+				return client.GameState{}, err
+			}
+		}
+	}
+	if err := addSeen(p.Hand); err != nil {
+		return client.GameState{}, err
+	}
+	if err := addSeen(p.Trick); err != nil {
+		return client.GameState{}, err
+	}
+	if len(p.Hand) != 13-numTaken {
+		return client.GameState{}, fmt.Errorf("hand has %d cards after %d tricks, want %d", len(p.Hand), numTaken, 13-numTaken)
+	}
+	if len(p.Trick) > 3 {
+		return client.GameState{}, fmt.Errorf("trick has %d cards, at most 3 are played before you", len(p.Trick))
+	}
+
+	pos := &hearts.Position{
+		Trick:      p.Trick,
+		Leader:     (4 - len(p.Trick)) % 4,
+		FirstTrick: numTaken == 0,
+	}
+	pos.Hands[0] = p.Hand
+	for _, c := range p.Trick {
+		pos.HeartsBroken = pos.HeartsBroken || c.Suit == cards.Hearts
+	}
+	for _, tricks := range p.Taken {
+		for _, t := range tricks {
+			pos.HeartsBroken = pos.HeartsBroken || t.ContainsSuit(cards.Hearts)
+		}
+	}
+	gs := client.GameState{
+		Phase:        client.Playing,
+		CurrentTrick: p.Trick,
+		LegalPlays:   pos.LegalPlays(),
+	}
+	for _, c := range p.Accept {
+		if !gs.LegalPlays.ContainsCard(c) {
+			return client.GameState{}, fmt.Errorf("accepted play %s is not one of the legal plays %s", c, gs.LegalPlays)
+		}
+	}
+	if len(p.Accept) == 0 {
+		return client.GameState{}, fmt.Errorf("no accepted plays")
+	}
+	for seat, name := range seatNames {
+		ps := client.PlayerState{
+			Id:         strconv.Itoa(seat),
+			Name:       name,
+			NumCards:   13 - numTaken,
+			Tricks:     p.Taken[seat],
+			NumTricks:  len(p.Taken[seat]),
+			TrickScore: points(p.Taken[seat]),
+			IsNext:     seat == 0,
+		}
+		if seat == 0 {
+			ps.Cards = p.Hand
+		} else if seat >= pos.Leader && len(p.Trick) > 0 {
+			ps.NumCards-- // Already played to the current trick.
+		}
+		gs.Players = append(gs.Players, ps)
+	}
+	return gs, nil
+}
+
+func points(tricks []cards.Cards) int {
+	total := 0
+	for _, t := range tricks {
+		for _, c := range t {
+			if c.Suit == cards.Hearts {
+				total++
+			}
+			if c == cards.Cqs {
+				total += 13
+			}
+		}
+	}
+	return total
+}
+
+// The outcome of one position.
+type Result struct {
+	Position Position
+	Played   cards.Card
+	Passed   bool
+}
+
+// Plays every position with a fresh strategy from newStrategy.
+func Run(positions []Position, newStrategy func() (player.PlayerStrategy, error)) ([]Result, error) {
+	var results []Result
+	for _, p := range positions {
+		gs, err := p.GameState()
+		if err != nil {
+			return nil, fmt.Errorf("position %s: %w", p.Name, err)
+		}
+		strategy, err := newStrategy()
+		if err != nil {
+			return nil, err
+		}
+		if o, ok := strategy.(player.GameObserver); ok {
+			o.GameStarted(gs)
+		}
+		played := strategy.ChooseCardToPlay(gs)
+		if c, ok := strategy.(io.Closer); ok {
+			c.Close()
+		}
+		results = append(results, Result{Position: p, Played: played, Passed: p.Accept.ContainsCard(played)})
+	}
+	return results, nil
+}
+
+// Returns the fraction of results that passed.
+func Score(results []Result) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+	passed := 0
+	for _, r := range results {
+		if r.Passed {
+			passed++
+		}
+	}
+	return float64(passed) / float64(len(results))
+}
+
+// Writes a line for each failed position, or every position if verbose, followed by the score.
+func WriteReport(w io.Writer, results []Result, verbose bool) error {
+	width := 0
+	for _, r := range results {
+		if len(r.Position.Name) > width {
+			width = len(r.Position.Name)
+		}
+	}
+	passed := 0
+	for _, r := range results {
+		if r.Passed {
+			passed++
+		}
+		if r.Passed && !verbose {
+			continue
+		}
+		status := "FAIL"
+		if r.Passed {
+			status = "pass"
+		}
+		if _, err := fmt.Fprintf(w, "%s  %-*s  played %s, accepts %s\n", status, width, r.Position.Name, r.Played, r.Position.Accept); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Passed %d of %d positions (%.1f%%)\n", passed, len(results), 100*Score(results))
+	return err
+}
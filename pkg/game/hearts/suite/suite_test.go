@@ -0,0 +1,84 @@
+package suite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
+)
+
+func TestDefaultSuiteMatchesBasicStrategy(t *testing.T) {
+	positions, err := ReadSuiteFile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	covered := map[string]bool{}
+	for _, p := range positions {
+		gs, err := p.GameState()
+		if err != nil {
+			t.Fatalf("position %s: %v", p.Name, err)
+		}
+		e := player.ExplainBasicStrategyCard(gs)
+		if e.Rule != p.Rule {
+			t.Errorf("position %s: basic strategy used rule %s (%s), want %s", p.Name, e.Rule, e.Card, p.Rule)
+		}
+		if !p.Accept.ContainsCard(e.Card) {
+			t.Errorf("position %s: basic strategy played %s, want one of %s", p.Name, e.Card, p.Accept)
+		}
+		covered[e.Rule] = true
+	}
+	// Every reachable rule of chooseLeadCard, followSpadesWhenQueenOutstanding, followSuit and chooseDumpCard.
+	for _, rule := range []string{
+		"lead-low-nonspade", "lead-low-spade", "flush-queen", "lead-low",
+		"drop-queen", "keep-queen", "last-high-spade", "spade-under-queen", "high-spade",
+		"take-cheap-trick", "forced-take", "duck-last", "duck-hearts", "first-round-high",
+		"duck-queen-out", "early-round-high", "duck",
+		"dump-queen", "dump-high-spade", "dump-heart", "dump-weak-suit",
+	} {
+		if !covered[rule] {
+			t.Errorf("no position covers rule %s", rule)
+		}
+	}
+}
+
+func TestRunReportsFailures(t *testing.T) {
+	positions, err := ReadSuite(strings.NewReader(`
+position lead-2c
+hand 2c 5c 9c 3d 7d jd 4h 8h qh 2s 6s ts ks
+accept 2c
+
+# Nobody should lead the ks here, so every strategy fails.
+position lead-low-nonspade
+hand 3c 8c 4d jd 5h ks 3s 7s 9d 6h 2h qd
+taken left 2c ac 5c tc
+accept ks
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := Run(positions, func() (player.PlayerStrategy, error) { return player.NewStrategyFromFlag("basic") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !results[0].Passed || results[1].Passed {
+		t.Errorf("got results %v, want the first to pass and the second to fail", results)
+	}
+	if got := Score(results); got != 0.5 {
+		t.Errorf("Score = %v, want 0.5", got)
+	}
+}
+
+func TestReadSuiteErrors(t *testing.T) {
+	for _, tc := range []struct{ name, suite, want string }{
+		{"short hand", "position p\nhand 2c\naccept 2c\n", "hand has 1 cards"},
+		{"repeated card", "position p\nhand 2c 2c 9c 3d 7d jd 4h 8h qh 2s 6s ts ks\naccept 2c\n", "more than once"},
+		{"illegal accept", "position p\nhand 2c 5c 9c 3d 7d jd 4h 8h qh 2s 6s ts ks\naccept 5c\n", "not one of the legal plays"},
+		{"unknown seat", "position p\ntaken north 2c ac 5c tc\n", "unknown seat"},
+		{"unknown key", "position p\nscore 3\n", "unknown key"},
+	} {
+		_, err := ReadSuite(strings.NewReader(tc.suite))
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got error %v, want %q", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+)
+
+// Results of a tournament for each strategy in the lineup.
+// Confidence intervals are 95% intervals that treat every seat played as an independent sample.
+type Report struct {
+	NumHands   int              `json:"hands"`
+	Strategies []*StrategyStats `json:"strategies"`
+}
+
+type StrategyStats struct {
+	Name        string  `json:"name"`
+	Hands       int     `json:"hands"` // Number of seats played.
+	MeanScore   float64 `json:"mean_score"`
+	MeanScoreCI float64 `json:"mean_score_ci95"`
+	// A hand is won by the lowest score, with ties sharing the win.
+	WinRate   float64 `json:"win_rate"`
+	WinRateCI float64 `json:"win_rate_ci95"`
+	Moons     int     `json:"moons"`
+	MoonRate  float64 `json:"moon_rate"`
+	MoonCI    float64 `json:"moon_rate_ci95"`
+
+	sum, sumSquares, wins float64
+}
+
+func newReport(lineup []string) *Report {
+	r := &Report{}
+	for _, name := range lineup {
+		if r.stats(name) == nil {
+			r.Strategies = append(r.Strategies, &StrategyStats{Name: name})
+		}
+	}
+	return r
+}
+
+func (r *Report) stats(name string) *StrategyStats {
+	for _, s := range r.Strategies {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func (r *Report) add(h handResult) {
+	r.NumHands++
+	best := h.scores[0]
+	for _, score := range h.scores {
+		if score < best {
+			best = score
+		}
+	}
+	numWinners := 0
+	for _, score := range h.scores {
+		if score == best {
+			numWinners++
+		}
+	}
+	for seat, name := range h.lineup {
+		s := r.stats(name)
+		score := float64(h.scores[seat])
+		s.Hands++
+		s.sum += score
+		s.sumSquares += score * score
+		if h.scores[seat] == best {
+			s.wins += 1 / float64(numWinners)
+		}
+		if h.moon == seat {
+			s.Moons++
+		}
+	}
+}
+
+func (r *Report) finish() {
+	for _, s := range r.Strategies {
+		if s.Hands == 0 {
+			continue
+		}
+		n := float64(s.Hands)
+		s.MeanScore = s.sum / n
+		if s.Hands > 1 {
+			variance := (s.sumSquares - n*s.MeanScore*s.MeanScore) / (n - 1)
+			s.MeanScoreCI = 1.96 * math.Sqrt(math.Max(variance, 0)/n)
+		}
+		s.WinRate = s.wins / n
+		s.WinRateCI = proportionCI(s.WinRate, n)
+		s.MoonRate = float64(s.Moons) / n
+		s.MoonCI = proportionCI(s.MoonRate, n)
+	}
+}
+
+func proportionCI(p, n float64) float64 {
+	return 1.96 * math.Sqrt(p*(1-p)/n)
+}
+
+// Writes the report as a table, one line per strategy.
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Strategy\tHands\tMean score\tWin rate\tMoons\n")
+	for _, s := range r.Strategies {
+		fmt.Fprintf(tw, "%s\t%d\t%.2f ± %.2f\t%.1f%% ± %.1f%%\t%.2f%% ± %.2f%%\n",
+			s.Name, s.Hands, s.MeanScore, s.MeanScoreCI,
+			100*s.WinRate, 100*s.WinRateCI, 100*s.MoonRate, 100*s.MoonCI)
+	}
+	return tw.Flush()
+}
+
+// Writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
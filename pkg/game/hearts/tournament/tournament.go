@@ -0,0 +1,256 @@
+// Package tournament plays many hands of hearts between player strategies and
+// reports how each strategy did.
+//
+// Hands are simulated directly on a hearts.Position rather than through a server,
+// so that thousands of them can be played quickly. Each player sees the same
+// GameState and events it would see from a server.
+package tournament
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+	"github.com/mpsalisbury/cards/pkg/game/hearts/player"
+)
+
+type Config struct {
+	// Player flag values (see player.NewStrategyFromFlag), one per seat.
+	// Fewer than four are repeated in order to fill the table.
+	Lineup []string
+	// Number of deals to play. Each deal is played once per rotation of the lineup.
+	NumDeals int
+	// If true, every deal is played four times with the lineup rotated one seat each time,
+	// so that every seat of every deal is played by every strategy.
+	Duplicate bool
+	// Deals to play instead of random ones. NumDeals is ignored if set.
+	Deals []cards.DealRecord
+	// Number of hands to play at once. 0 means one per CPU.
+	Parallel int
+	// Seed for the random deals.
+	Seed int64
+}
+
+// The outcome of one hand.
+type handResult struct {
+	lineup [4]string // Strategy in each seat.
+	scores [4]int
+	moon   int // Seat that shot the moon, or -1.
+}
+
+// Plays the tournament and returns the results for each strategy in the lineup.
+func Run(ctx context.Context, config Config) (*Report, error) {
+	lineup, err := fillLineup(config.Lineup)
+	if err != nil {
+		return nil, err
+	}
+	deals, err := makeDeals(config)
+	if err != nil {
+		return nil, err
+	}
+	rotations := 1
+	if config.Duplicate {
+		rotations = 4
+	}
+	type job struct {
+		hands  [4]cards.Cards
+		lineup [4]string
+	}
+	jobs := make(chan job)
+	results := make(chan handResult)
+	errs := make(chan error, 1)
+
+	parallel := config.Parallel
+	if parallel <= 0 {
+		parallel = runtime.NumCPU()
+	}
+	wg := new(sync.WaitGroup)
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				r, err := playHand(j.hands, j.lineup)
+				if err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+					continue
+				}
+				results <- r
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for d, hands := range deals {
+			for r := 0; r < rotations; r++ {
+				// Without duplicate deals, rotate the lineup from one deal to the next instead.
+				shift := r
+				if !config.Duplicate {
+					shift = d
+				}
+				var seats [4]string
+				for i := range seats {
+					seats[i] = lineup[(i+shift)%4]
+				}
+				select {
+				case jobs <- job{hands, seats}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	report := newReport(config.Lineup)
+	for r := range results {
+		report.add(r)
+	}
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	report.finish()
+	return report, nil
+}
+
+func fillLineup(names []string) ([4]string, error) {
+	var lineup [4]string
+	if len(names) == 0 || len(names) > 4 {
+		return lineup, fmt.Errorf("lineup must have between 1 and 4 players, found %d", len(names))
+	}
+	for _, name := range names {
+		if _, err := player.NewStrategyFromFlag(name); err != nil {
+			return lineup, err
+		}
+	}
+	for i := range lineup {
+		lineup[i] = names[i%len(names)]
+	}
+	return lineup, nil
+}
+
+func makeDeals(config Config) ([][4]cards.Cards, error) {
+	var deals [][4]cards.Cards
+	if len(config.Deals) > 0 {
+		for i, d := range config.Deals {
+			if err := d.Validate(); err != nil {
+				return nil, fmt.Errorf("deal %d: %v", i+1, err)
+			}
+			deals = append(deals, d.Hands)
+		}
+		return deals, nil
+	}
+	if config.NumDeals <= 0 {
+		return nil, fmt.Errorf("number of deals must be positive, found %d", config.NumDeals)
+	}
+	rng := rand.New(rand.NewSource(config.Seed))
+	for i := 0; i < config.NumDeals; i++ {
+		deck := cards.MakeDeck()
+		rng.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+		var hands [4]cards.Cards
+		for seat := range hands {
+			hands[seat] = deck[seat*13 : (seat+1)*13]
+			hands[seat].Sort()
+		}
+		deals = append(deals, hands)
+	}
+	return deals, nil
+}
+
+// Plays one hand with a fresh strategy for each seat.
+func playHand(hands [4]cards.Cards, lineup [4]string) (handResult, error) {
+	result := handResult{lineup: lineup, moon: -1}
+	pos := &hearts.Position{FirstTrick: true}
+	for seat, h := range hands {
+		pos.Hands[seat] = h.Copy()
+		if h.ContainsCard(cards.C2c) {
+			pos.Leader = seat
+		}
+	}
+	var strategies [4]player.PlayerStrategy
+	var choosers [4]player.HistoryStrategy
+	var trackers [4]*player.Tracker
+	var history player.HandHistory
+	var tricks [4][]cards.Cards
+	for seat, name := range lineup {
+		strategy, err := player.NewStrategyFromFlag(name)
+		if err != nil {
+			return result, err
+		}
+		strategies[seat] = strategy
+		choosers[seat] = player.AsHistoryStrategy(strategy)
+		trackers[seat] = player.NewTracker()
+		trackers[seat].StartHand(player.GameStateForSeat(pos, tricks, seat))
+		if ts, ok := strategy.(player.TrackingStrategy); ok {
+			ts.SetTracker(trackers[seat])
+		}
+		if c, ok := strategy.(io.Closer); ok {
+			defer c.Close()
+		}
+	}
+	for seat, strategy := range strategies {
+		if o, ok := strategy.(player.GameObserver); ok {
+			o.GameStarted(player.GameStateForSeat(pos, tricks, seat))
+		}
+	}
+	for !pos.IsOver() {
+		seat := pos.NextSeat()
+		gs := player.GameStateForSeat(pos, tricks, seat)
+		trackers[seat].ObserveTurn(gs)
+		card := choosers[seat].ChooseCardWithHistory(gs, &history)
+		if !gs.LegalPlays.ContainsCard(card) {
+			return result, fmt.Errorf("%s in seat %s played %s, which is not one of %s", lineup[seat], cards.Seat(seat), card, gs.LegalPlays)
+		}
+		trick := append(pos.Trick.Copy(), card)
+		pos.Play(card)
+		history.AddPlay(strconv.Itoa(seat), card)
+		if len(trick) == 4 {
+			winner := pos.Leader
+			history.CompleteTrick(strconv.Itoa(winner))
+			tricks[winner] = append(tricks[winner], trick)
+			for _, t := range trackers {
+				t.TrickCompleted(trick, trick.LeadingCardOfTrick(), strconv.Itoa(winner))
+			}
+			for _, strategy := range strategies {
+				if o, ok := strategy.(player.GameObserver); ok {
+					o.TrickCompleted(trick, trick.LeadingCardOfTrick(), strconv.Itoa(winner))
+				}
+			}
+		}
+	}
+	for seat, points := range pos.Points {
+		if points == 26 {
+			result.moon = seat
+		}
+	}
+	result.scores = pos.HandScores()
+	for seat, strategy := range strategies {
+		if o, ok := strategy.(player.GameObserver); ok {
+			gs := player.GameStateForSeat(pos, tricks, seat)
+			gs.Phase = client.Completed
+			for i := range gs.Players {
+				gs.Players[i].HandScore = result.scores[(seat+i)%4]
+			}
+			o.GameFinished(gs)
+		}
+	}
+	return result, nil
+}
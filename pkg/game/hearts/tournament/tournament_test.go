@@ -0,0 +1,39 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(context.Background(), Config{
+		Lineup:    []string{"tracker", "random"},
+		NumDeals:  20,
+		Duplicate: true,
+		Seed:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.NumHands != 80 {
+		t.Errorf("NumHands=%d, want 80", report.NumHands)
+	}
+	totalWins := 0.0
+	for _, s := range report.Strategies {
+		if s.Hands != 160 {
+			t.Errorf("%s played %d seats, want 160", s.Name, s.Hands)
+		}
+		totalWins += s.WinRate * float64(s.Hands)
+	}
+	if totalWins < 79.99 || totalWins > 80.01 {
+		t.Errorf("total wins=%f, want one per hand", totalWins)
+	}
+}
+
+func TestRunBadLineup(t *testing.T) {
+	for _, lineup := range [][]string{nil, {"term"}, {"basic", "nonsense"}, {"basic", "basic", "basic", "basic", "basic"}} {
+		if _, err := Run(context.Background(), Config{Lineup: lineup, NumDeals: 1}); err == nil {
+			t.Errorf("Run(%v) succeeded, want error", lineup)
+		}
+	}
+}
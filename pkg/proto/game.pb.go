@@ -20,6 +20,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CreateGameRequest_Visibility int32
+
+const (
+	CreateGameRequest_Public  CreateGameRequest_Visibility = 0
+	CreateGameRequest_Private CreateGameRequest_Visibility = 1 // Not listed by ListGames. Join it by its id.
+)
+
+// Enum value maps for CreateGameRequest_Visibility.
+var (
+	CreateGameRequest_Visibility_name = map[int32]string{
+		0: "Public",
+		1: "Private",
+	}
+	CreateGameRequest_Visibility_value = map[string]int32{
+		"Public":  0,
+		"Private": 1,
+	}
+)
+
+func (x CreateGameRequest_Visibility) Enum() *CreateGameRequest_Visibility {
+	p := new(CreateGameRequest_Visibility)
+	*p = x
+	return p
+}
+
+func (x CreateGameRequest_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateGameRequest_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[0].Descriptor()
+}
+
+func (CreateGameRequest_Visibility) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[0]
+}
+
+func (x CreateGameRequest_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateGameRequest_Visibility.Descriptor instead.
+func (CreateGameRequest_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type LeaderboardRequest_Order int32
+
+const (
+	LeaderboardRequest_WinRate          LeaderboardRequest_Order = 0
+	LeaderboardRequest_AverageHandScore LeaderboardRequest_Order = 1 // Lowest first.
+	LeaderboardRequest_GamesPlayed      LeaderboardRequest_Order = 2
+	LeaderboardRequest_MoonShots        LeaderboardRequest_Order = 3
+	LeaderboardRequest_Rating           LeaderboardRequest_Order = 4
+)
+
+// Enum value maps for LeaderboardRequest_Order.
+var (
+	LeaderboardRequest_Order_name = map[int32]string{
+		0: "WinRate",
+		1: "AverageHandScore",
+		2: "GamesPlayed",
+		3: "MoonShots",
+		4: "Rating",
+	}
+	LeaderboardRequest_Order_value = map[string]int32{
+		"WinRate":          0,
+		"AverageHandScore": 1,
+		"GamesPlayed":      2,
+		"MoonShots":        3,
+		"Rating":           4,
+	}
+)
+
+func (x LeaderboardRequest_Order) Enum() *LeaderboardRequest_Order {
+	p := new(LeaderboardRequest_Order)
+	*p = x
+	return p
+}
+
+func (x LeaderboardRequest_Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardRequest_Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardRequest_Order) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[1]
+}
+
+func (x LeaderboardRequest_Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardRequest_Order.Descriptor instead.
+func (LeaderboardRequest_Order) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17, 0}
+}
+
 type GameState_Phase int32
 
 const (
@@ -59,11 +160,11 @@ func (x GameState_Phase) String() string {
 }
 
 func (GameState_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_proto_enumTypes[0].Descriptor()
+	return file_game_proto_enumTypes[2].Descriptor()
 }
 
 func (GameState_Phase) Type() protoreflect.EnumType {
-	return &file_game_proto_enumTypes[0]
+	return &file_game_proto_enumTypes[2]
 }
 
 func (x GameState_Phase) Number() protoreflect.EnumNumber {
@@ -72,7 +173,112 @@ func (x GameState_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameState_Phase.Descriptor instead.
 func (GameState_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13, 0}
+	return file_game_proto_rawDescGZIP(), []int{28, 0}
+}
+
+// Play passes clockwise, from North to East, South and West.
+type GameState_Seat int32
+
+const (
+	GameState_NoSeat GameState_Seat = 0
+	GameState_North  GameState_Seat = 1
+	GameState_East   GameState_Seat = 2
+	GameState_South  GameState_Seat = 3
+	GameState_West   GameState_Seat = 4
+)
+
+// Enum value maps for GameState_Seat.
+var (
+	GameState_Seat_name = map[int32]string{
+		0: "NoSeat",
+		1: "North",
+		2: "East",
+		3: "South",
+		4: "West",
+	}
+	GameState_Seat_value = map[string]int32{
+		"NoSeat": 0,
+		"North":  1,
+		"East":   2,
+		"South":  3,
+		"West":   4,
+	}
+)
+
+func (x GameState_Seat) Enum() *GameState_Seat {
+	p := new(GameState_Seat)
+	*p = x
+	return p
+}
+
+func (x GameState_Seat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState_Seat) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[3].Descriptor()
+}
+
+func (GameState_Seat) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[3]
+}
+
+func (x GameState_Seat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameState_Seat.Descriptor instead.
+func (GameState_Seat) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28, 1}
+}
+
+type GameAnalysis_Decision_Grade int32
+
+const (
+	GameAnalysis_Decision_Good       GameAnalysis_Decision_Grade = 0
+	GameAnalysis_Decision_Inaccuracy GameAnalysis_Decision_Grade = 1
+	GameAnalysis_Decision_Blunder    GameAnalysis_Decision_Grade = 2
+)
+
+// Enum value maps for GameAnalysis_Decision_Grade.
+var (
+	GameAnalysis_Decision_Grade_name = map[int32]string{
+		0: "Good",
+		1: "Inaccuracy",
+		2: "Blunder",
+	}
+	GameAnalysis_Decision_Grade_value = map[string]int32{
+		"Good":       0,
+		"Inaccuracy": 1,
+		"Blunder":    2,
+	}
+)
+
+func (x GameAnalysis_Decision_Grade) Enum() *GameAnalysis_Decision_Grade {
+	p := new(GameAnalysis_Decision_Grade)
+	*p = x
+	return p
+}
+
+func (x GameAnalysis_Decision_Grade) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameAnalysis_Decision_Grade) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[4].Descriptor()
+}
+
+func (GameAnalysis_Decision_Grade) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[4]
+}
+
+func (x GameAnalysis_Decision_Grade) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameAnalysis_Decision_Grade.Descriptor instead.
+func (GameAnalysis_Decision_Grade) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36, 0, 0}
 }
 
 type RegisterRequest struct {
@@ -175,6 +381,23 @@ type CreateGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Starting hands, dealt to the players by seat, from North.
+	// If empty, the cards are shuffled and dealt randomly.
+	Hands []*GameState_Cards `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
+	// Cards already played, in order, which are played again by whoever holds them as soon
+	// as the game starts, to continue a recorded game. Needs hands.
+	Plays      []string                     `protobuf:"bytes,2,rep,name=plays,proto3" json:"plays,omitempty"`
+	Visibility CreateGameRequest_Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=cards.proto.CreateGameRequest_Visibility" json:"visibility,omitempty"`
+	// If set, needed to join the game.
+	JoinCode string `protobuf:"bytes,4,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	// Seats kept for players with these names until they join.
+	ReservedNames []string `protobuf:"bytes,5,rep,name=reserved_names,json=reservedNames,proto3" json:"reserved_names,omitempty"`
+	// Session of the game's host, who can kick players and add bots before it starts, and start it early.
+	// If empty, the game has no host, and anyone can add bots.
+	SessionId string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Players sitting across from each other are partners, North and South against East and West.
+	Partnerships bool `protobuf:"varint,7,opt,name=partnerships,proto3" json:"partnerships,omitempty"`
 }
 
 func (x *CreateGameRequest) Reset() {
@@ -209,6 +432,55 @@ func (*CreateGameRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *CreateGameRequest) GetHands() []*GameState_Cards {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetPlays() []string {
+	if x != nil {
+		return x.Plays
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetVisibility() CreateGameRequest_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return CreateGameRequest_Public
+}
+
+func (x *CreateGameRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetReservedNames() []string {
+	if x != nil {
+		return x.ReservedNames
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetPartnerships() bool {
+	if x != nil {
+		return x.Partnerships
+	}
+	return false
+}
+
 type CreateGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,6 +535,8 @@ type JoinGameRequest struct {
 
 	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Needed for a game created with a join code.
+	JoinCode string `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
 }
 
 func (x *JoinGameRequest) Reset() {
@@ -311,6 +585,13 @@ func (x *JoinGameRequest) GetGameId() string {
 	return ""
 }
 
+func (x *JoinGameRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
 type ListGamesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -413,6 +694,8 @@ type ObserveGameRequest struct {
 
 	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Needed to watch a game created with a join code.
+	JoinCode string `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
 }
 
 func (x *ObserveGameRequest) Reset() {
@@ -461,22 +744,23 @@ func (x *ObserveGameRequest) GetGameId() string {
 	return ""
 }
 
-type GameActionRequest struct {
+func (x *ObserveGameRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type ResumeSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Types that are assignable to Type:
-	//	*GameActionRequest_ReadyToStartGame
-	//	*GameActionRequest_LeaveGame
-	//	*GameActionRequest_PlayCard
-	Type isGameActionRequest_Type `protobuf_oneof:"type"`
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *GameActionRequest) Reset() {
-	*x = GameActionRequest{}
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +768,13 @@ func (x *GameActionRequest) Reset() {
 	}
 }
 
-func (x *GameActionRequest) String() string {
+func (x *ResumeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActionRequest) ProtoMessage() {}
+func (*ResumeSessionRequest) ProtoMessage() {}
 
-func (x *GameActionRequest) ProtoReflect() protoreflect.Message {
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,83 +786,31 @@ func (x *GameActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActionRequest.ProtoReflect.Descriptor instead.
-func (*GameActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GameActionRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *GameActionRequest) GetGameId() string {
+func (x *ResumeSessionRequest) GetResumeToken() string {
 	if x != nil {
-		return x.GameId
+		return x.ResumeToken
 	}
 	return ""
 }
 
-func (m *GameActionRequest) GetType() isGameActionRequest_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
-
-func (x *GameActionRequest) GetReadyToStartGame() *ReadyToStartGameAction {
-	if x, ok := x.GetType().(*GameActionRequest_ReadyToStartGame); ok {
-		return x.ReadyToStartGame
-	}
-	return nil
-}
-
-func (x *GameActionRequest) GetLeaveGame() *LeaveGameAction {
-	if x, ok := x.GetType().(*GameActionRequest_LeaveGame); ok {
-		return x.LeaveGame
-	}
-	return nil
-}
-
-func (x *GameActionRequest) GetPlayCard() *PlayCardAction {
-	if x, ok := x.GetType().(*GameActionRequest_PlayCard); ok {
-		return x.PlayCard
-	}
-	return nil
-}
-
-type isGameActionRequest_Type interface {
-	isGameActionRequest_Type()
-}
-
-type GameActionRequest_ReadyToStartGame struct {
-	ReadyToStartGame *ReadyToStartGameAction `protobuf:"bytes,3,opt,name=ready_to_start_game,json=readyToStartGame,proto3,oneof"`
-}
-
-type GameActionRequest_LeaveGame struct {
-	LeaveGame *LeaveGameAction `protobuf:"bytes,4,opt,name=leave_game,json=leaveGame,proto3,oneof"`
-}
-
-type GameActionRequest_PlayCard struct {
-	PlayCard *PlayCardAction `protobuf:"bytes,5,opt,name=play_card,json=playCard,proto3,oneof"`
-}
-
-func (*GameActionRequest_ReadyToStartGame) isGameActionRequest_Type() {}
-
-func (*GameActionRequest_LeaveGame) isGameActionRequest_Type() {}
-
-func (*GameActionRequest_PlayCard) isGameActionRequest_Type() {}
-
-type ReadyToStartGameAction struct {
+type RejoinGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	GameId      string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// How many activities were received from the game's earlier streams. Those after them are sent again.
+	ActivitiesReceived int32 `protobuf:"varint,3,opt,name=activities_received,json=activitiesReceived,proto3" json:"activities_received,omitempty"`
 }
 
-func (x *ReadyToStartGameAction) Reset() {
-	*x = ReadyToStartGameAction{}
+func (x *RejoinGameRequest) Reset() {
+	*x = RejoinGameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,13 +818,13 @@ func (x *ReadyToStartGameAction) Reset() {
 	}
 }
 
-func (x *ReadyToStartGameAction) String() string {
+func (x *RejoinGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyToStartGameAction) ProtoMessage() {}
+func (*RejoinGameRequest) ProtoMessage() {}
 
-func (x *ReadyToStartGameAction) ProtoReflect() protoreflect.Message {
+func (x *RejoinGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,19 +836,42 @@ func (x *ReadyToStartGameAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyToStartGameAction.ProtoReflect.Descriptor instead.
-func (*ReadyToStartGameAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejoinGameRequest.ProtoReflect.Descriptor instead.
+func (*RejoinGameRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{9}
 }
 
-type LeaveGameAction struct {
+func (x *RejoinGameRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *RejoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RejoinGameRequest) GetActivitiesReceived() int32 {
+	if x != nil {
+		return x.ActivitiesReceived
+	}
+	return 0
+}
+
+type GameHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *LeaveGameAction) Reset() {
-	*x = LeaveGameAction{}
+func (x *GameHistoryRequest) Reset() {
+	*x = GameHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -624,13 +879,13 @@ func (x *LeaveGameAction) Reset() {
 	}
 }
 
-func (x *LeaveGameAction) String() string {
+func (x *GameHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveGameAction) ProtoMessage() {}
+func (*GameHistoryRequest) ProtoMessage() {}
 
-func (x *LeaveGameAction) ProtoReflect() protoreflect.Message {
+func (x *GameHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,21 +897,33 @@ func (x *LeaveGameAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveGameAction.ProtoReflect.Descriptor instead.
-func (*LeaveGameAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GameHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{10}
 }
 
-type PlayCardAction struct {
+func (x *GameHistoryRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ListPastGamesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Card string `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	// Only games this player played in, if set.
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	// Only games started at or after this time, in Unix milliseconds, if set.
+	StartedAfter int64 `protobuf:"varint,2,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	// Only games started before this time, in Unix milliseconds, if set.
+	StartedBefore int64 `protobuf:"varint,3,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
 }
 
-func (x *PlayCardAction) Reset() {
-	*x = PlayCardAction{}
+func (x *ListPastGamesRequest) Reset() {
+	*x = ListPastGamesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,13 +931,13 @@ func (x *PlayCardAction) Reset() {
 	}
 }
 
-func (x *PlayCardAction) String() string {
+func (x *ListPastGamesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayCardAction) ProtoMessage() {}
+func (*ListPastGamesRequest) ProtoMessage() {}
 
-func (x *PlayCardAction) ProtoReflect() protoreflect.Message {
+func (x *ListPastGamesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,30 +949,42 @@ func (x *PlayCardAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayCardAction.ProtoReflect.Descriptor instead.
-func (*PlayCardAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPastGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListPastGamesRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PlayCardAction) GetCard() string {
+func (x *ListPastGamesRequest) GetPlayerName() string {
 	if x != nil {
-		return x.Card
+		return x.PlayerName
 	}
 	return ""
 }
 
-type GameStateRequest struct {
+func (x *ListPastGamesRequest) GetStartedAfter() int64 {
+	if x != nil {
+		return x.StartedAfter
+	}
+	return 0
+}
+
+func (x *ListPastGamesRequest) GetStartedBefore() int64 {
+	if x != nil {
+		return x.StartedBefore
+	}
+	return 0
+}
+
+type ListPastGamesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// If session_id is present, returned game state will hide other players' cards.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Games []*ListPastGamesResponse_PastGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
 }
 
-func (x *GameStateRequest) Reset() {
-	*x = GameStateRequest{}
+func (x *ListPastGamesResponse) Reset() {
+	*x = ListPastGamesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,13 +992,13 @@ func (x *GameStateRequest) Reset() {
 	}
 }
 
-func (x *GameStateRequest) String() string {
+func (x *ListPastGamesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameStateRequest) ProtoMessage() {}
+func (*ListPastGamesResponse) ProtoMessage() {}
 
-func (x *GameStateRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPastGamesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,39 +1010,34 @@ func (x *GameStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameStateRequest.ProtoReflect.Descriptor instead.
-func (*GameStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPastGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListPastGamesResponse) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GameStateRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *GameStateRequest) GetGameId() string {
+func (x *ListPastGamesResponse) GetGames() []*ListPastGamesResponse_PastGame {
 	if x != nil {
-		return x.GameId
+		return x.Games
 	}
-	return ""
+	return nil
 }
 
-type GameState struct {
+type JoinQueueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Phase        GameState_Phase     `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"`
-	Players      []*GameState_Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	CurrentTrick *GameState_Cards    `protobuf:"bytes,4,opt,name=current_trick,json=currentTrick,proto3" json:"current_trick,omitempty"`
-	LegalPlays   *GameState_Cards    `protobuf:"bytes,5,opt,name=legal_plays,json=legalPlays,proto3" json:"legal_plays,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Only "hearts" so far. Empty is hearts.
+	GameType string `protobuf:"bytes,2,opt,name=game_type,json=gameType,proto3" json:"game_type,omitempty"`
+	// Only "standard" so far. Empty is standard.
+	Rules string `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	// Only play with players whose ratings are within this much of yours, if set.
+	MaxRatingGap float64 `protobuf:"fixed64,4,opt,name=max_rating_gap,json=maxRatingGap,proto3" json:"max_rating_gap,omitempty"`
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,13 +1045,13 @@ func (x *GameState) Reset() {
 	}
 }
 
-func (x *GameState) String() string {
+func (x *JoinQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*JoinQueueRequest) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,57 +1063,52 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GameState) GetId() string {
+func (x *JoinQueueRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GameState) GetPhase() GameState_Phase {
-	if x != nil {
-		return x.Phase
-	}
-	return GameState_Unknown
-}
-
-func (x *GameState) GetPlayers() []*GameState_Player {
+func (x *JoinQueueRequest) GetGameType() string {
 	if x != nil {
-		return x.Players
+		return x.GameType
 	}
-	return nil
+	return ""
 }
 
-func (x *GameState) GetCurrentTrick() *GameState_Cards {
+func (x *JoinQueueRequest) GetRules() string {
 	if x != nil {
-		return x.CurrentTrick
+		return x.Rules
 	}
-	return nil
+	return ""
 }
 
-func (x *GameState) GetLegalPlays() *GameState_Cards {
+func (x *JoinQueueRequest) GetMaxRatingGap() float64 {
 	if x != nil {
-		return x.LegalPlays
+		return x.MaxRatingGap
 	}
-	return nil
+	return 0
 }
 
-type Status struct {
+type QueueActivity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`  // 0 == ok
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // if code != 0
+	// Types that are assignable to Type:
+	//	*QueueActivity_Waiting_
+	//	*QueueActivity_GameAssigned_
+	Type isQueueActivity_Type `protobuf_oneof:"type"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *QueueActivity) Reset() {
+	*x = QueueActivity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,13 +1116,13 @@ func (x *Status) Reset() {
 	}
 }
 
-func (x *Status) String() string {
+func (x *QueueActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*QueueActivity) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *QueueActivity) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,47 +1134,58 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueActivity.ProtoReflect.Descriptor instead.
+func (*QueueActivity) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Status) GetCode() int32 {
-	if x != nil {
-		return x.Code
+func (m *QueueActivity) GetType() isQueueActivity_Type {
+	if m != nil {
+		return m.Type
 	}
-	return 0
+	return nil
 }
 
-func (x *Status) GetError() string {
-	if x != nil {
-		return x.Error
+func (x *QueueActivity) GetWaiting() *QueueActivity_Waiting {
+	if x, ok := x.GetType().(*QueueActivity_Waiting_); ok {
+		return x.Waiting
 	}
-	return ""
+	return nil
 }
 
-type GameActivity struct {
+func (x *QueueActivity) GetGameAssigned() *QueueActivity_GameAssigned {
+	if x, ok := x.GetType().(*QueueActivity_GameAssigned_); ok {
+		return x.GameAssigned
+	}
+	return nil
+}
+
+type isQueueActivity_Type interface {
+	isQueueActivity_Type()
+}
+
+type QueueActivity_Waiting_ struct {
+	Waiting *QueueActivity_Waiting `protobuf:"bytes,1,opt,name=waiting,proto3,oneof"`
+}
+
+type QueueActivity_GameAssigned_ struct {
+	GameAssigned *QueueActivity_GameAssigned `protobuf:"bytes,2,opt,name=game_assigned,json=gameAssigned,proto3,oneof"`
+}
+
+func (*QueueActivity_Waiting_) isQueueActivity_Type() {}
+
+func (*QueueActivity_GameAssigned_) isQueueActivity_Type() {}
+
+type AnalyzeGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Types that are assignable to Type:
-	//	*GameActivity_PlayerJoined_
-	//	*GameActivity_PlayerLeft_
-	//	*GameActivity_GameReadyToStart_
-	//	*GameActivity_GameStarted_
-	//	*GameActivity_CardPlayed_
-	//	*GameActivity_TrickCompleted_
-	//	*GameActivity_YourTurn_
-	//	*GameActivity_GameFinished_
-	//	*GameActivity_GameAborted_
-	//	*GameActivity_BroadcastMsg
-	Type isGameActivity_Type `protobuf_oneof:"type"`
 }
 
-func (x *GameActivity) Reset() {
-	*x = GameActivity{}
+func (x *AnalyzeGameRequest) Reset() {
+	*x = AnalyzeGameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,13 +1193,13 @@ func (x *GameActivity) Reset() {
 	}
 }
 
-func (x *GameActivity) String() string {
+func (x *AnalyzeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity) ProtoMessage() {}
+func (*AnalyzeGameRequest) ProtoMessage() {}
 
-func (x *GameActivity) ProtoReflect() protoreflect.Message {
+func (x *AnalyzeGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,184 +1211,2144 @@ func (x *GameActivity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity.ProtoReflect.Descriptor instead.
-func (*GameActivity) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnalyzeGameRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeGameRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GameActivity) GetGameId() string {
+func (x *AnalyzeGameRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (m *GameActivity) GetType() isGameActivity_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
+type PlayerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GameActivity) GetPlayerJoined() *GameActivity_PlayerJoined {
-	if x, ok := x.GetType().(*GameActivity_PlayerJoined_); ok {
-		return x.PlayerJoined
-	}
-	return nil
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
 }
 
-func (x *GameActivity) GetPlayerLeft() *GameActivity_PlayerLeft {
-	if x, ok := x.GetType().(*GameActivity_PlayerLeft_); ok {
-		return x.PlayerLeft
+func (x *PlayerStatsRequest) Reset() {
+	*x = PlayerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GameActivity) GetGameReadyToStart() *GameActivity_GameReadyToStart {
-	if x, ok := x.GetType().(*GameActivity_GameReadyToStart_); ok {
-		return x.GameReadyToStart
-	}
-	return nil
+func (x *PlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameActivity) GetGameStarted() *GameActivity_GameStarted {
-	if x, ok := x.GetType().(*GameActivity_GameStarted_); ok {
-		return x.GameStarted
+func (*PlayerStatsRequest) ProtoMessage() {}
+
+func (x *PlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameActivity) GetCardPlayed() *GameActivity_CardPlayed {
-	if x, ok := x.GetType().(*GameActivity_CardPlayed_); ok {
-		return x.CardPlayed
-	}
-	return nil
+// Deprecated: Use PlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*PlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GameActivity) GetTrickCompleted() *GameActivity_TrickCompleted {
-	if x, ok := x.GetType().(*GameActivity_TrickCompleted_); ok {
-		return x.TrickCompleted
+func (x *PlayerStatsRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
 	}
-	return nil
+	return ""
 }
 
-func (x *GameActivity) GetYourTurn() *GameActivity_YourTurn {
-	if x, ok := x.GetType().(*GameActivity_YourTurn_); ok {
-		return x.YourTurn
-	}
-	return nil
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order LeaderboardRequest_Order `protobuf:"varint,1,opt,name=order,proto3,enum=cards.proto.LeaderboardRequest_Order" json:"order,omitempty"`
+	// Only players who completed at least this many games, if set.
+	MinGames int32 `protobuf:"varint,2,opt,name=min_games,json=minGames,proto3" json:"min_games,omitempty"`
+	// At most this many players, if set.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GameActivity) GetGameFinished() *GameActivity_GameFinished {
-	if x, ok := x.GetType().(*GameActivity_GameFinished_); ok {
-		return x.GameFinished
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GameActivity) GetGameAborted() *GameActivity_GameAborted {
-	if x, ok := x.GetType().(*GameActivity_GameAborted_); ok {
-		return x.GameAborted
-	}
-	return nil
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameActivity) GetBroadcastMsg() string {
-	if x, ok := x.GetType().(*GameActivity_BroadcastMsg); ok {
-		return x.BroadcastMsg
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type isGameActivity_Type interface {
-	isGameActivity_Type()
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17}
 }
 
-type GameActivity_PlayerJoined_ struct {
-	PlayerJoined *GameActivity_PlayerJoined `protobuf:"bytes,10,opt,name=player_joined,json=playerJoined,proto3,oneof"`
+func (x *LeaderboardRequest) GetOrder() LeaderboardRequest_Order {
+	if x != nil {
+		return x.Order
+	}
+	return LeaderboardRequest_WinRate
 }
 
-type GameActivity_PlayerLeft_ struct {
-	PlayerLeft *GameActivity_PlayerLeft `protobuf:"bytes,11,opt,name=player_left,json=playerLeft,proto3,oneof"`
+func (x *LeaderboardRequest) GetMinGames() int32 {
+	if x != nil {
+		return x.MinGames
+	}
+	return 0
 }
 
-type GameActivity_GameReadyToStart_ struct {
-	GameReadyToStart *GameActivity_GameReadyToStart `protobuf:"bytes,12,opt,name=game_ready_to_start,json=gameReadyToStart,proto3,oneof"`
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-type GameActivity_GameStarted_ struct {
-	GameStarted *GameActivity_GameStarted `protobuf:"bytes,13,opt,name=game_started,json=gameStarted,proto3,oneof"`
-}
+type Leaderboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type GameActivity_CardPlayed_ struct {
-	CardPlayed *GameActivity_CardPlayed `protobuf:"bytes,14,opt,name=card_played,json=cardPlayed,proto3,oneof"`
+	Players []*PlayerStats `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // Best first.
 }
 
-type GameActivity_TrickCompleted_ struct {
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Leaderboard) GetPlayers() []*PlayerStats {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type GameActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are assignable to Type:
+	//	*GameActionRequest_ReadyToStartGame
+	//	*GameActionRequest_LeaveGame
+	//	*GameActionRequest_PlayCard
+	//	*GameActionRequest_AddBot
+	//	*GameActionRequest_KickPlayer
+	//	*GameActionRequest_StartGame
+	//	*GameActionRequest_ChooseSeat
+	Type isGameActionRequest_Type `protobuf_oneof:"type"`
+}
+
+func (x *GameActionRequest) Reset() {
+	*x = GameActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameActionRequest) ProtoMessage() {}
+
+func (x *GameActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameActionRequest.ProtoReflect.Descriptor instead.
+func (*GameActionRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GameActionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GameActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (m *GameActionRequest) GetType() isGameActionRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetReadyToStartGame() *ReadyToStartGameAction {
+	if x, ok := x.GetType().(*GameActionRequest_ReadyToStartGame); ok {
+		return x.ReadyToStartGame
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetLeaveGame() *LeaveGameAction {
+	if x, ok := x.GetType().(*GameActionRequest_LeaveGame); ok {
+		return x.LeaveGame
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetPlayCard() *PlayCardAction {
+	if x, ok := x.GetType().(*GameActionRequest_PlayCard); ok {
+		return x.PlayCard
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetAddBot() *AddBotAction {
+	if x, ok := x.GetType().(*GameActionRequest_AddBot); ok {
+		return x.AddBot
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetKickPlayer() *KickPlayerAction {
+	if x, ok := x.GetType().(*GameActionRequest_KickPlayer); ok {
+		return x.KickPlayer
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetStartGame() *StartGameAction {
+	if x, ok := x.GetType().(*GameActionRequest_StartGame); ok {
+		return x.StartGame
+	}
+	return nil
+}
+
+func (x *GameActionRequest) GetChooseSeat() *ChooseSeatAction {
+	if x, ok := x.GetType().(*GameActionRequest_ChooseSeat); ok {
+		return x.ChooseSeat
+	}
+	return nil
+}
+
+type isGameActionRequest_Type interface {
+	isGameActionRequest_Type()
+}
+
+type GameActionRequest_ReadyToStartGame struct {
+	ReadyToStartGame *ReadyToStartGameAction `protobuf:"bytes,3,opt,name=ready_to_start_game,json=readyToStartGame,proto3,oneof"`
+}
+
+type GameActionRequest_LeaveGame struct {
+	LeaveGame *LeaveGameAction `protobuf:"bytes,4,opt,name=leave_game,json=leaveGame,proto3,oneof"`
+}
+
+type GameActionRequest_PlayCard struct {
+	PlayCard *PlayCardAction `protobuf:"bytes,5,opt,name=play_card,json=playCard,proto3,oneof"`
+}
+
+type GameActionRequest_AddBot struct {
+	AddBot *AddBotAction `protobuf:"bytes,6,opt,name=add_bot,json=addBot,proto3,oneof"`
+}
+
+type GameActionRequest_KickPlayer struct {
+	KickPlayer *KickPlayerAction `protobuf:"bytes,7,opt,name=kick_player,json=kickPlayer,proto3,oneof"`
+}
+
+type GameActionRequest_StartGame struct {
+	StartGame *StartGameAction `protobuf:"bytes,8,opt,name=start_game,json=startGame,proto3,oneof"`
+}
+
+type GameActionRequest_ChooseSeat struct {
+	ChooseSeat *ChooseSeatAction `protobuf:"bytes,9,opt,name=choose_seat,json=chooseSeat,proto3,oneof"`
+}
+
+func (*GameActionRequest_ReadyToStartGame) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_LeaveGame) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_PlayCard) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_AddBot) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_KickPlayer) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_StartGame) isGameActionRequest_Type() {}
+
+func (*GameActionRequest_ChooseSeat) isGameActionRequest_Type() {}
+
+type ReadyToStartGameAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReadyToStartGameAction) Reset() {
+	*x = ReadyToStartGameAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyToStartGameAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyToStartGameAction) ProtoMessage() {}
+
+func (x *ReadyToStartGameAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyToStartGameAction.ProtoReflect.Descriptor instead.
+func (*ReadyToStartGameAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{20}
+}
+
+type LeaveGameAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveGameAction) Reset() {
+	*x = LeaveGameAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveGameAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGameAction) ProtoMessage() {}
+
+func (x *LeaveGameAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGameAction.ProtoReflect.Descriptor instead.
+func (*LeaveGameAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{21}
+}
+
+type PlayCardAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card string `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *PlayCardAction) Reset() {
+	*x = PlayCardAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayCardAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayCardAction) ProtoMessage() {}
+
+func (x *PlayCardAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayCardAction.ProtoReflect.Descriptor instead.
+func (*PlayCardAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlayCardAction) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
+}
+
+// Asks the server to fill a seat in a Preparing game with a computer player.
+type AddBotAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerType string `protobuf:"bytes,1,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // e.g. "basic" or "expert"
+}
+
+func (x *AddBotAction) Reset() {
+	*x = AddBotAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBotAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotAction) ProtoMessage() {}
+
+func (x *AddBotAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotAction.ProtoReflect.Descriptor instead.
+func (*AddBotAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddBotAction) GetPlayerType() string {
+	if x != nil {
+		return x.PlayerType
+	}
+	return ""
+}
+
+// Asks the server to remove a player from a Preparing game. Only its host can.
+type KickPlayerAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *KickPlayerAction) Reset() {
+	*x = KickPlayerAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickPlayerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerAction) ProtoMessage() {}
+
+func (x *KickPlayerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerAction.ProtoReflect.Descriptor instead.
+func (*KickPlayerAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KickPlayerAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Asks the server to fill the empty seats of a Preparing game with computer players, so
+// it starts without waiting for more people. Only its host can.
+type StartGameAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerType string `protobuf:"bytes,1,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // e.g. "basic" or "expert". Empty is basic.
+}
+
+func (x *StartGameAction) Reset() {
+	*x = StartGameAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameAction) ProtoMessage() {}
+
+func (x *StartGameAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameAction.ProtoReflect.Descriptor instead.
+func (*StartGameAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StartGameAction) GetPlayerType() string {
+	if x != nil {
+		return x.PlayerType
+	}
+	return ""
+}
+
+// Moves a player in a Preparing game to an empty seat.
+type ChooseSeatAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat GameState_Seat `protobuf:"varint,1,opt,name=seat,proto3,enum=cards.proto.GameState_Seat" json:"seat,omitempty"`
+}
+
+func (x *ChooseSeatAction) Reset() {
+	*x = ChooseSeatAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChooseSeatAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChooseSeatAction) ProtoMessage() {}
+
+func (x *ChooseSeatAction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChooseSeatAction.ProtoReflect.Descriptor instead.
+func (*ChooseSeatAction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChooseSeatAction) GetSeat() GameState_Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return GameState_NoSeat
+}
+
+type GameStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If session_id is present, returned game state will hide other players' cards.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Needed for a game created with a join code, unless session_id is playing in or watching it.
+	JoinCode string `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+}
+
+func (x *GameStateRequest) Reset() {
+	*x = GameStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStateRequest) ProtoMessage() {}
+
+func (x *GameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStateRequest.ProtoReflect.Descriptor instead.
+func (*GameStateRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GameStateRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GameStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameStateRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase GameState_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"`
+	// In turn order, starting with the requesting player. While Preparing, only who sits where, from North.
+	Players      []*GameState_Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	CurrentTrick *GameState_Cards    `protobuf:"bytes,4,opt,name=current_trick,json=currentTrick,proto3" json:"current_trick,omitempty"`
+	LegalPlays   *GameState_Cards    `protobuf:"bytes,5,opt,name=legal_plays,json=legalPlays,proto3" json:"legal_plays,omitempty"`
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameState) GetPhase() GameState_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GameState_Unknown
+}
+
+func (x *GameState) GetPlayers() []*GameState_Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetCurrentTrick() *GameState_Cards {
+	if x != nil {
+		return x.CurrentTrick
+	}
+	return nil
+}
+
+func (x *GameState) GetLegalPlays() *GameState_Cards {
+	if x != nil {
+		return x.LegalPlays
+	}
+	return nil
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`  // 0 == ok
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // if code != 0
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Status) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GameActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are assignable to Type:
+	//	*GameActivity_PlayerJoined_
+	//	*GameActivity_PlayerLeft_
+	//	*GameActivity_GameReadyToStart_
+	//	*GameActivity_GameStarted_
+	//	*GameActivity_CardPlayed_
+	//	*GameActivity_TrickCompleted_
+	//	*GameActivity_YourTurn_
+	//	*GameActivity_GameFinished_
+	//	*GameActivity_GameAborted_
+	//	*GameActivity_BroadcastMsg
+	Type isGameActivity_Type `protobuf_oneof:"type"`
+}
+
+func (x *GameActivity) Reset() {
+	*x = GameActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameActivity) ProtoMessage() {}
+
+func (x *GameActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameActivity.ProtoReflect.Descriptor instead.
+func (*GameActivity) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GameActivity) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (m *GameActivity) GetType() isGameActivity_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *GameActivity) GetPlayerJoined() *GameActivity_PlayerJoined {
+	if x, ok := x.GetType().(*GameActivity_PlayerJoined_); ok {
+		return x.PlayerJoined
+	}
+	return nil
+}
+
+func (x *GameActivity) GetPlayerLeft() *GameActivity_PlayerLeft {
+	if x, ok := x.GetType().(*GameActivity_PlayerLeft_); ok {
+		return x.PlayerLeft
+	}
+	return nil
+}
+
+func (x *GameActivity) GetGameReadyToStart() *GameActivity_GameReadyToStart {
+	if x, ok := x.GetType().(*GameActivity_GameReadyToStart_); ok {
+		return x.GameReadyToStart
+	}
+	return nil
+}
+
+func (x *GameActivity) GetGameStarted() *GameActivity_GameStarted {
+	if x, ok := x.GetType().(*GameActivity_GameStarted_); ok {
+		return x.GameStarted
+	}
+	return nil
+}
+
+func (x *GameActivity) GetCardPlayed() *GameActivity_CardPlayed {
+	if x, ok := x.GetType().(*GameActivity_CardPlayed_); ok {
+		return x.CardPlayed
+	}
+	return nil
+}
+
+func (x *GameActivity) GetTrickCompleted() *GameActivity_TrickCompleted {
+	if x, ok := x.GetType().(*GameActivity_TrickCompleted_); ok {
+		return x.TrickCompleted
+	}
+	return nil
+}
+
+func (x *GameActivity) GetYourTurn() *GameActivity_YourTurn {
+	if x, ok := x.GetType().(*GameActivity_YourTurn_); ok {
+		return x.YourTurn
+	}
+	return nil
+}
+
+func (x *GameActivity) GetGameFinished() *GameActivity_GameFinished {
+	if x, ok := x.GetType().(*GameActivity_GameFinished_); ok {
+		return x.GameFinished
+	}
+	return nil
+}
+
+func (x *GameActivity) GetGameAborted() *GameActivity_GameAborted {
+	if x, ok := x.GetType().(*GameActivity_GameAborted_); ok {
+		return x.GameAborted
+	}
+	return nil
+}
+
+func (x *GameActivity) GetBroadcastMsg() string {
+	if x, ok := x.GetType().(*GameActivity_BroadcastMsg); ok {
+		return x.BroadcastMsg
+	}
+	return ""
+}
+
+type isGameActivity_Type interface {
+	isGameActivity_Type()
+}
+
+type GameActivity_PlayerJoined_ struct {
+	PlayerJoined *GameActivity_PlayerJoined `protobuf:"bytes,10,opt,name=player_joined,json=playerJoined,proto3,oneof"`
+}
+
+type GameActivity_PlayerLeft_ struct {
+	PlayerLeft *GameActivity_PlayerLeft `protobuf:"bytes,11,opt,name=player_left,json=playerLeft,proto3,oneof"`
+}
+
+type GameActivity_GameReadyToStart_ struct {
+	GameReadyToStart *GameActivity_GameReadyToStart `protobuf:"bytes,12,opt,name=game_ready_to_start,json=gameReadyToStart,proto3,oneof"`
+}
+
+type GameActivity_GameStarted_ struct {
+	GameStarted *GameActivity_GameStarted `protobuf:"bytes,13,opt,name=game_started,json=gameStarted,proto3,oneof"`
+}
+
+type GameActivity_CardPlayed_ struct {
+	CardPlayed *GameActivity_CardPlayed `protobuf:"bytes,14,opt,name=card_played,json=cardPlayed,proto3,oneof"`
+}
+
+type GameActivity_TrickCompleted_ struct {
 	TrickCompleted *GameActivity_TrickCompleted `protobuf:"bytes,15,opt,name=trick_completed,json=trickCompleted,proto3,oneof"`
 }
 
-type GameActivity_YourTurn_ struct {
-	YourTurn *GameActivity_YourTurn `protobuf:"bytes,16,opt,name=your_turn,json=yourTurn,proto3,oneof"` // for player listener
+type GameActivity_YourTurn_ struct {
+	YourTurn *GameActivity_YourTurn `protobuf:"bytes,16,opt,name=your_turn,json=yourTurn,proto3,oneof"` // for player listener
+}
+
+type GameActivity_GameFinished_ struct {
+	GameFinished *GameActivity_GameFinished `protobuf:"bytes,17,opt,name=game_finished,json=gameFinished,proto3,oneof"`
+}
+
+type GameActivity_GameAborted_ struct {
+	GameAborted *GameActivity_GameAborted `protobuf:"bytes,18,opt,name=game_aborted,json=gameAborted,proto3,oneof"`
+}
+
+type GameActivity_BroadcastMsg struct {
+	BroadcastMsg string `protobuf:"bytes,19,opt,name=broadcast_msg,json=broadcastMsg,proto3,oneof"`
+}
+
+func (*GameActivity_PlayerJoined_) isGameActivity_Type() {}
+
+func (*GameActivity_PlayerLeft_) isGameActivity_Type() {}
+
+func (*GameActivity_GameReadyToStart_) isGameActivity_Type() {}
+
+func (*GameActivity_GameStarted_) isGameActivity_Type() {}
+
+func (*GameActivity_CardPlayed_) isGameActivity_Type() {}
+
+func (*GameActivity_TrickCompleted_) isGameActivity_Type() {}
+
+func (*GameActivity_YourTurn_) isGameActivity_Type() {}
+
+func (*GameActivity_GameFinished_) isGameActivity_Type() {}
+
+func (*GameActivity_GameAborted_) isGameActivity_Type() {}
+
+func (*GameActivity_BroadcastMsg) isGameActivity_Type() {}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RegistryActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*RegistryActivity_SessionCreated_
+	//	*RegistryActivity_GameCreated_
+	//	*RegistryActivity_GameDeleted_
+	//	*RegistryActivity_FullGamesList_
+	Type isRegistryActivity_Type `protobuf_oneof:"type"`
+}
+
+func (x *RegistryActivity) Reset() {
+	*x = RegistryActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistryActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryActivity) ProtoMessage() {}
+
+func (x *RegistryActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryActivity.ProtoReflect.Descriptor instead.
+func (*RegistryActivity) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (m *RegistryActivity) GetType() isRegistryActivity_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *RegistryActivity) GetSessionCreated() *RegistryActivity_SessionCreated {
+	if x, ok := x.GetType().(*RegistryActivity_SessionCreated_); ok {
+		return x.SessionCreated
+	}
+	return nil
+}
+
+func (x *RegistryActivity) GetGameCreated() *RegistryActivity_GameCreated {
+	if x, ok := x.GetType().(*RegistryActivity_GameCreated_); ok {
+		return x.GameCreated
+	}
+	return nil
+}
+
+func (x *RegistryActivity) GetGameDeleted() *RegistryActivity_GameDeleted {
+	if x, ok := x.GetType().(*RegistryActivity_GameDeleted_); ok {
+		return x.GameDeleted
+	}
+	return nil
+}
+
+func (x *RegistryActivity) GetFullGamesList() *RegistryActivity_FullGamesList {
+	if x, ok := x.GetType().(*RegistryActivity_FullGamesList_); ok {
+		return x.FullGamesList
+	}
+	return nil
+}
+
+type isRegistryActivity_Type interface {
+	isRegistryActivity_Type()
+}
+
+type RegistryActivity_SessionCreated_ struct {
+	SessionCreated *RegistryActivity_SessionCreated `protobuf:"bytes,1,opt,name=session_created,json=sessionCreated,proto3,oneof"`
+}
+
+type RegistryActivity_GameCreated_ struct {
+	GameCreated *RegistryActivity_GameCreated `protobuf:"bytes,2,opt,name=game_created,json=gameCreated,proto3,oneof"`
+}
+
+type RegistryActivity_GameDeleted_ struct {
+	GameDeleted *RegistryActivity_GameDeleted `protobuf:"bytes,3,opt,name=game_deleted,json=gameDeleted,proto3,oneof"`
+}
+
+type RegistryActivity_FullGamesList_ struct {
+	FullGamesList *RegistryActivity_FullGamesList `protobuf:"bytes,4,opt,name=full_games_list,json=fullGamesList,proto3,oneof"`
+}
+
+func (*RegistryActivity_SessionCreated_) isRegistryActivity_Type() {}
+
+func (*RegistryActivity_GameCreated_) isRegistryActivity_Type() {}
+
+func (*RegistryActivity_GameDeleted_) isRegistryActivity_Type() {}
+
+func (*RegistryActivity_FullGamesList_) isRegistryActivity_Type() {}
+
+// A game saved by a server so that it can be restored after the server restarts.
+// It isn't part of the service.
+type SavedGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The game's own state, from its Snapshot.
+	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Sessions of the people playing in the game's seats.
+	Sessions []*SavedGame_Session `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// Session playing each taken-over seat, keyed by the seat's player id. "" while empty.
+	Occupants map[string]string `protobuf:"bytes,4,rep,name=occupants,proto3" json:"occupants,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Name of the player who left each taken-over seat, keyed by the seat's player id.
+	LeftBy map[string]string `protobuf:"bytes,5,rep,name=left_by,json=leftBy,proto3" json:"left_by,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// What has happened in the game so far.
+	History      *GameHistory `protobuf:"bytes,6,opt,name=history,proto3" json:"history,omitempty"`
+	Private      bool         `protobuf:"varint,7,opt,name=private,proto3" json:"private,omitempty"`
+	JoinCode     string       `protobuf:"bytes,8,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	Host         string       `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"` // Session id
+	Partnerships bool         `protobuf:"varint,10,opt,name=partnerships,proto3" json:"partnerships,omitempty"`
+}
+
+func (x *SavedGame) Reset() {
+	*x = SavedGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedGame) ProtoMessage() {}
+
+func (x *SavedGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedGame.ProtoReflect.Descriptor instead.
+func (*SavedGame) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SavedGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SavedGame) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *SavedGame) GetSessions() []*SavedGame_Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *SavedGame) GetOccupants() map[string]string {
+	if x != nil {
+		return x.Occupants
+	}
+	return nil
+}
+
+func (x *SavedGame) GetLeftBy() map[string]string {
+	if x != nil {
+		return x.LeftBy
+	}
+	return nil
+}
+
+func (x *SavedGame) GetHistory() *GameHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *SavedGame) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *SavedGame) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *SavedGame) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SavedGame) GetPartnerships() bool {
+	if x != nil {
+		return x.Partnerships
+	}
+	return false
+}
+
+// The record of a game, as an ordered log of what happened in it.
+type GameHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId  string                `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Phase   GameState_Phase       `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"` // Playing until the game is over.
+	Players []*GameHistory_Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`                               // In turn order, as dealt.
+	Events  []*GameHistory_Event  `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GameHistory) Reset() {
+	*x = GameHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameHistory) ProtoMessage() {}
+
+func (x *GameHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameHistory.ProtoReflect.Descriptor instead.
+func (*GameHistory) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameHistory) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameHistory) GetPhase() GameState_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GameState_Unknown
+}
+
+func (x *GameHistory) GetPlayers() []*GameHistory_Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameHistory) GetEvents() []*GameHistory_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// A review of the decisions in a game, comparing each card played with the engine's evaluation.
+type GameAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Every play where there was a choice, in order.
+	Decisions []*GameAnalysis_Decision      `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	Players   []*GameAnalysis_PlayerSummary `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"` // By seat.
+}
+
+func (x *GameAnalysis) Reset() {
+	*x = GameAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAnalysis) ProtoMessage() {}
+
+func (x *GameAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameAnalysis.ProtoReflect.Descriptor instead.
+func (*GameAnalysis) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GameAnalysis) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameAnalysis) GetDecisions() []*GameAnalysis_Decision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *GameAnalysis) GetPlayers() []*GameAnalysis_PlayerSummary {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// Totals over the completed games a player started. A player who leaves is still credited
+// with the game, including the cards played for them by a replacement bot.
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GamesPlayed        int32   `protobuf:"varint,2,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins               int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"` // Games with the lowest hand score, including ties.
+	TotalHandScore     int32   `protobuf:"varint,4,opt,name=total_hand_score,json=totalHandScore,proto3" json:"total_hand_score,omitempty"`
+	MoonShots          int32   `protobuf:"varint,5,opt,name=moon_shots,json=moonShots,proto3" json:"moon_shots,omitempty"`
+	QueenOfSpadesTaken int32   `protobuf:"varint,6,opt,name=queen_of_spades_taken,json=queenOfSpadesTaken,proto3" json:"queen_of_spades_taken,omitempty"`
+	Rating             float64 `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PlayerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTotalHandScore() int32 {
+	if x != nil {
+		return x.TotalHandScore
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMoonShots() int32 {
+	if x != nil {
+		return x.MoonShots
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetQueenOfSpadesTaken() int32 {
+	if x != nil {
+		return x.QueenOfSpadesTaken
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// Skill ratings of everyone who has completed a game, people and bots alike.
+type Ratings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratings []*Ratings_Rating `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+}
+
+func (x *Ratings) Reset() {
+	*x = Ratings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ratings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ratings) ProtoMessage() {}
+
+func (x *Ratings) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ratings.ProtoReflect.Descriptor instead.
+func (*Ratings) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Ratings) GetRatings() []*Ratings_Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type ListGamesResponse_GameSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase         GameState_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"`
+	PlayerNames   []string        `protobuf:"bytes,3,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
+	PlayerRatings []float64       `protobuf:"fixed64,4,rep,packed,name=player_ratings,json=playerRatings,proto3" json:"player_ratings,omitempty"` // In the order of player_names.
+}
+
+func (x *ListGamesResponse_GameSummary) Reset() {
+	*x = ListGamesResponse_GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesResponse_GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse_GameSummary) ProtoMessage() {}
+
+func (x *ListGamesResponse_GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse_GameSummary.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse_GameSummary) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ListGamesResponse_GameSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListGamesResponse_GameSummary) GetPhase() GameState_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GameState_Unknown
+}
+
+func (x *ListGamesResponse_GameSummary) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *ListGamesResponse_GameSummary) GetPlayerRatings() []float64 {
+	if x != nil {
+		return x.PlayerRatings
+	}
+	return nil
+}
+
+type ListPastGamesResponse_PastGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase       GameState_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"`   // Completed or Aborted
+	PlayerNames []string        `protobuf:"bytes,3,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`      // In turn order.
+	HandScores  []int32         `protobuf:"varint,4,rep,packed,name=hand_scores,json=handScores,proto3" json:"hand_scores,omitempty"` // In the order of player_names. Empty if the game was aborted.
+	StartTime   int64           `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`           // Unix milliseconds
+	EndTime     int64           `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                 // Unix milliseconds
+}
+
+func (x *ListPastGamesResponse_PastGame) Reset() {
+	*x = ListPastGamesResponse_PastGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPastGamesResponse_PastGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPastGamesResponse_PastGame) ProtoMessage() {}
+
+func (x *ListPastGamesResponse_PastGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPastGamesResponse_PastGame.ProtoReflect.Descriptor instead.
+func (*ListPastGamesResponse_PastGame) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ListPastGamesResponse_PastGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListPastGamesResponse_PastGame) GetPhase() GameState_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GameState_Unknown
+}
+
+func (x *ListPastGamesResponse_PastGame) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *ListPastGamesResponse_PastGame) GetHandScores() []int32 {
+	if x != nil {
+		return x.HandScores
+	}
+	return nil
+}
+
+func (x *ListPastGamesResponse_PastGame) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListPastGamesResponse_PastGame) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type QueueActivity_Waiting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"` // Waiting at your table, including you.
+	Seats   int32 `protobuf:"varint,2,opt,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *QueueActivity_Waiting) Reset() {
+	*x = QueueActivity_Waiting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueActivity_Waiting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueActivity_Waiting) ProtoMessage() {}
+
+func (x *QueueActivity_Waiting) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueActivity_Waiting.ProtoReflect.Descriptor instead.
+func (*QueueActivity_Waiting) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *QueueActivity_Waiting) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *QueueActivity_Waiting) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+type QueueActivity_GameAssigned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Join it with JoinGame. The stream ends after this.
+}
+
+func (x *QueueActivity_GameAssigned) Reset() {
+	*x = QueueActivity_GameAssigned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueActivity_GameAssigned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueActivity_GameAssigned) ProtoMessage() {}
+
+func (x *QueueActivity_GameAssigned) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueActivity_GameAssigned.ProtoReflect.Descriptor instead.
+func (*QueueActivity_GameAssigned) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *QueueActivity_GameAssigned) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GameState_Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cards        *GameState_Cards   `protobuf:"bytes,3,opt,name=cards,proto3" json:"cards,omitempty"` // not populated for other players
+	NumCards     int32              `protobuf:"varint,4,opt,name=num_cards,json=numCards,proto3" json:"num_cards,omitempty"`
+	Tricks       []*GameState_Cards `protobuf:"bytes,5,rep,name=tricks,proto3" json:"tricks,omitempty"`
+	NumTricks    int32              `protobuf:"varint,6,opt,name=num_tricks,json=numTricks,proto3" json:"num_tricks,omitempty"`
+	TrickScore   int32              `protobuf:"varint,7,opt,name=trick_score,json=trickScore,proto3" json:"trick_score,omitempty"` // sum of scores of all taken tricks
+	IsNextPlayer bool               `protobuf:"varint,8,opt,name=is_next_player,json=isNextPlayer,proto3" json:"is_next_player,omitempty"`
+	HandScore    int32              `protobuf:"varint,9,opt,name=hand_score,json=handScore,proto3" json:"hand_score,omitempty"` // after game is Completed, score for this hand (may be different than trick_score).
+	IsBot        bool               `protobuf:"varint,10,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`            // played by the server
+	Rating       float64            `protobuf:"fixed64,11,opt,name=rating,proto3" json:"rating,omitempty"`                      // Skill rating from the player's completed games.
+	Seat         GameState_Seat     `protobuf:"varint,12,opt,name=seat,proto3,enum=cards.proto.GameState_Seat" json:"seat,omitempty"`
+	Team         int32              `protobuf:"varint,13,opt,name=team,proto3" json:"team,omitempty"` // 1 for North and South, 2 for East and West, in a partnership game. Otherwise 0.
+}
+
+func (x *GameState_Player) Reset() {
+	*x = GameState_Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState_Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState_Player) ProtoMessage() {}
+
+func (x *GameState_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState_Player.ProtoReflect.Descriptor instead.
+func (*GameState_Player) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *GameState_Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameState_Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameState_Player) GetCards() *GameState_Cards {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *GameState_Player) GetNumCards() int32 {
+	if x != nil {
+		return x.NumCards
+	}
+	return 0
+}
+
+func (x *GameState_Player) GetTricks() []*GameState_Cards {
+	if x != nil {
+		return x.Tricks
+	}
+	return nil
+}
+
+func (x *GameState_Player) GetNumTricks() int32 {
+	if x != nil {
+		return x.NumTricks
+	}
+	return 0
 }
 
-type GameActivity_GameFinished_ struct {
-	GameFinished *GameActivity_GameFinished `protobuf:"bytes,17,opt,name=game_finished,json=gameFinished,proto3,oneof"`
+func (x *GameState_Player) GetTrickScore() int32 {
+	if x != nil {
+		return x.TrickScore
+	}
+	return 0
 }
 
-type GameActivity_GameAborted_ struct {
-	GameAborted *GameActivity_GameAborted `protobuf:"bytes,18,opt,name=game_aborted,json=gameAborted,proto3,oneof"`
+func (x *GameState_Player) GetIsNextPlayer() bool {
+	if x != nil {
+		return x.IsNextPlayer
+	}
+	return false
 }
 
-type GameActivity_BroadcastMsg struct {
-	BroadcastMsg string `protobuf:"bytes,19,opt,name=broadcast_msg,json=broadcastMsg,proto3,oneof"`
+func (x *GameState_Player) GetHandScore() int32 {
+	if x != nil {
+		return x.HandScore
+	}
+	return 0
 }
 
-func (*GameActivity_PlayerJoined_) isGameActivity_Type() {}
+func (x *GameState_Player) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
 
-func (*GameActivity_PlayerLeft_) isGameActivity_Type() {}
+func (x *GameState_Player) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
 
-func (*GameActivity_GameReadyToStart_) isGameActivity_Type() {}
+func (x *GameState_Player) GetSeat() GameState_Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return GameState_NoSeat
+}
 
-func (*GameActivity_GameStarted_) isGameActivity_Type() {}
+func (x *GameState_Player) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
 
-func (*GameActivity_CardPlayed_) isGameActivity_Type() {}
+type GameState_Cards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*GameActivity_TrickCompleted_) isGameActivity_Type() {}
+	Cards []string `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+}
 
-func (*GameActivity_YourTurn_) isGameActivity_Type() {}
+func (x *GameState_Cards) Reset() {
+	*x = GameState_Cards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (*GameActivity_GameFinished_) isGameActivity_Type() {}
+func (x *GameState_Cards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*GameActivity_GameAborted_) isGameActivity_Type() {}
+func (*GameState_Cards) ProtoMessage() {}
 
-func (*GameActivity_BroadcastMsg) isGameActivity_Type() {}
+func (x *GameState_Cards) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type PingRequest struct {
+// Deprecated: Use GameState_Cards.ProtoReflect.Descriptor instead.
+func (*GameState_Cards) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *GameState_Cards) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type GameActivity_PlayerJoined struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *GameActivity_PlayerJoined) Reset() {
+	*x = GameActivity_PlayerJoined{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[16]
+		mi := &file_game_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *GameActivity_PlayerJoined) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*GameActivity_PlayerJoined) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[16]
+func (x *GameActivity_PlayerJoined) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,43 +3359,43 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GameActivity_PlayerJoined.ProtoReflect.Descriptor instead.
+func (*GameActivity_PlayerJoined) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (x *PingRequest) GetMessage() string {
+func (x *GameActivity_PlayerJoined) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type PingResponse struct {
+type GameActivity_PlayerLeft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *GameActivity_PlayerLeft) Reset() {
+	*x = GameActivity_PlayerLeft{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[17]
+		mi := &file_game_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *GameActivity_PlayerLeft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*GameActivity_PlayerLeft) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[17]
+func (x *GameActivity_PlayerLeft) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,48 +3406,41 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GameActivity_PlayerLeft.ProtoReflect.Descriptor instead.
+func (*GameActivity_PlayerLeft) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 1}
 }
 
-func (x *PingResponse) GetMessage() string {
+func (x *GameActivity_PlayerLeft) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type RegistryActivity struct {
+type GameActivity_GameReadyToStart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Type:
-	//	*RegistryActivity_SessionCreated_
-	//	*RegistryActivity_GameCreated_
-	//	*RegistryActivity_GameDeleted_
-	//	*RegistryActivity_FullGamesList_
-	Type isRegistryActivity_Type `protobuf_oneof:"type"`
 }
 
-func (x *RegistryActivity) Reset() {
-	*x = RegistryActivity{}
+func (x *GameActivity_GameReadyToStart) Reset() {
+	*x = GameActivity_GameReadyToStart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[18]
+		mi := &file_game_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryActivity) String() string {
+func (x *GameActivity_GameReadyToStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryActivity) ProtoMessage() {}
+func (*GameActivity_GameReadyToStart) ProtoMessage() {}
 
-func (x *RegistryActivity) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[18]
+func (x *GameActivity_GameReadyToStart) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,101 +3451,140 @@ func (x *RegistryActivity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryActivity.ProtoReflect.Descriptor instead.
-func (*RegistryActivity) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GameActivity_GameReadyToStart.ProtoReflect.Descriptor instead.
+func (*GameActivity_GameReadyToStart) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 2}
 }
 
-func (m *RegistryActivity) GetType() isRegistryActivity_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
+type GameActivity_GameStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RegistryActivity) GetSessionCreated() *RegistryActivity_SessionCreated {
-	if x, ok := x.GetType().(*RegistryActivity_SessionCreated_); ok {
-		return x.SessionCreated
+func (x *GameActivity_GameStarted) Reset() {
+	*x = GameActivity_GameStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *RegistryActivity) GetGameCreated() *RegistryActivity_GameCreated {
-	if x, ok := x.GetType().(*RegistryActivity_GameCreated_); ok {
-		return x.GameCreated
-	}
-	return nil
+func (x *GameActivity_GameStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RegistryActivity) GetGameDeleted() *RegistryActivity_GameDeleted {
-	if x, ok := x.GetType().(*RegistryActivity_GameDeleted_); ok {
-		return x.GameDeleted
+func (*GameActivity_GameStarted) ProtoMessage() {}
+
+func (x *GameActivity_GameStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RegistryActivity) GetFullGamesList() *RegistryActivity_FullGamesList {
-	if x, ok := x.GetType().(*RegistryActivity_FullGamesList_); ok {
-		return x.FullGamesList
+// Deprecated: Use GameActivity_GameStarted.ProtoReflect.Descriptor instead.
+func (*GameActivity_GameStarted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 3}
+}
+
+type GameActivity_CardPlayed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card       string `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	PlayerId   string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+}
+
+func (x *GameActivity_CardPlayed) Reset() {
+	*x = GameActivity_CardPlayed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isRegistryActivity_Type interface {
-	isRegistryActivity_Type()
+func (x *GameActivity_CardPlayed) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type RegistryActivity_SessionCreated_ struct {
-	SessionCreated *RegistryActivity_SessionCreated `protobuf:"bytes,1,opt,name=session_created,json=sessionCreated,proto3,oneof"`
+func (*GameActivity_CardPlayed) ProtoMessage() {}
+
+func (x *GameActivity_CardPlayed) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type RegistryActivity_GameCreated_ struct {
-	GameCreated *RegistryActivity_GameCreated `protobuf:"bytes,2,opt,name=game_created,json=gameCreated,proto3,oneof"`
+// Deprecated: Use GameActivity_CardPlayed.ProtoReflect.Descriptor instead.
+func (*GameActivity_CardPlayed) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 4}
 }
 
-type RegistryActivity_GameDeleted_ struct {
-	GameDeleted *RegistryActivity_GameDeleted `protobuf:"bytes,3,opt,name=game_deleted,json=gameDeleted,proto3,oneof"`
+func (x *GameActivity_CardPlayed) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
 }
 
-type RegistryActivity_FullGamesList_ struct {
-	FullGamesList *RegistryActivity_FullGamesList `protobuf:"bytes,4,opt,name=full_games_list,json=fullGamesList,proto3,oneof"`
+func (x *GameActivity_CardPlayed) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
 }
 
-func (*RegistryActivity_SessionCreated_) isRegistryActivity_Type() {}
-
-func (*RegistryActivity_GameCreated_) isRegistryActivity_Type() {}
-
-func (*RegistryActivity_GameDeleted_) isRegistryActivity_Type() {}
-
-func (*RegistryActivity_FullGamesList_) isRegistryActivity_Type() {}
+func (x *GameActivity_CardPlayed) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
 
-type ListGamesResponse_GameSummary struct {
+type GameActivity_TrickCompleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Phase       GameState_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=cards.proto.GameState_Phase" json:"phase,omitempty"`
-	PlayerNames []string        `protobuf:"bytes,3,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
+	Trick       []string `protobuf:"bytes,1,rep,name=trick,proto3" json:"trick,omitempty"`
+	WinningCard string   `protobuf:"bytes,2,opt,name=winning_card,json=winningCard,proto3" json:"winning_card,omitempty"`
+	WinnerId    string   `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	WinnerName  string   `protobuf:"bytes,4,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
 }
 
-func (x *ListGamesResponse_GameSummary) Reset() {
-	*x = ListGamesResponse_GameSummary{}
+func (x *GameActivity_TrickCompleted) Reset() {
+	*x = GameActivity_TrickCompleted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[19]
+		mi := &file_game_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListGamesResponse_GameSummary) String() string {
+func (x *GameActivity_TrickCompleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGamesResponse_GameSummary) ProtoMessage() {}
+func (*GameActivity_TrickCompleted) ProtoMessage() {}
 
-func (x *ListGamesResponse_GameSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[19]
+func (x *GameActivity_TrickCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,65 +3595,62 @@ func (x *ListGamesResponse_GameSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGamesResponse_GameSummary.ProtoReflect.Descriptor instead.
-func (*ListGamesResponse_GameSummary) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use GameActivity_TrickCompleted.ProtoReflect.Descriptor instead.
+func (*GameActivity_TrickCompleted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 5}
 }
 
-func (x *ListGamesResponse_GameSummary) GetId() string {
+func (x *GameActivity_TrickCompleted) GetTrick() []string {
 	if x != nil {
-		return x.Id
+		return x.Trick
+	}
+	return nil
+}
+
+func (x *GameActivity_TrickCompleted) GetWinningCard() string {
+	if x != nil {
+		return x.WinningCard
 	}
 	return ""
 }
 
-func (x *ListGamesResponse_GameSummary) GetPhase() GameState_Phase {
+func (x *GameActivity_TrickCompleted) GetWinnerId() string {
 	if x != nil {
-		return x.Phase
+		return x.WinnerId
 	}
-	return GameState_Unknown
+	return ""
 }
 
-func (x *ListGamesResponse_GameSummary) GetPlayerNames() []string {
+func (x *GameActivity_TrickCompleted) GetWinnerName() string {
 	if x != nil {
-		return x.PlayerNames
+		return x.WinnerName
 	}
-	return nil
+	return ""
 }
 
-type GameState_Player struct {
+type GameActivity_YourTurn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id           string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Cards        *GameState_Cards   `protobuf:"bytes,3,opt,name=cards,proto3" json:"cards,omitempty"` // not populated for other players
-	NumCards     int32              `protobuf:"varint,4,opt,name=num_cards,json=numCards,proto3" json:"num_cards,omitempty"`
-	Tricks       []*GameState_Cards `protobuf:"bytes,5,rep,name=tricks,proto3" json:"tricks,omitempty"`
-	NumTricks    int32              `protobuf:"varint,6,opt,name=num_tricks,json=numTricks,proto3" json:"num_tricks,omitempty"`
-	TrickScore   int32              `protobuf:"varint,7,opt,name=trick_score,json=trickScore,proto3" json:"trick_score,omitempty"` // sum of scores of all taken tricks
-	IsNextPlayer bool               `protobuf:"varint,8,opt,name=is_next_player,json=isNextPlayer,proto3" json:"is_next_player,omitempty"`
-	HandScore    int32              `protobuf:"varint,9,opt,name=hand_score,json=handScore,proto3" json:"hand_score,omitempty"` // after game is Completed, score for this hand (may be different than trick_score).
 }
 
-func (x *GameState_Player) Reset() {
-	*x = GameState_Player{}
+func (x *GameActivity_YourTurn) Reset() {
+	*x = GameActivity_YourTurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[20]
+		mi := &file_game_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameState_Player) String() string {
+func (x *GameActivity_YourTurn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState_Player) ProtoMessage() {}
+func (*GameActivity_YourTurn) ProtoMessage() {}
 
-func (x *GameState_Player) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[20]
+func (x *GameActivity_YourTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,99 +3661,114 @@ func (x *GameState_Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState_Player.ProtoReflect.Descriptor instead.
-func (*GameState_Player) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use GameActivity_YourTurn.ProtoReflect.Descriptor instead.
+func (*GameActivity_YourTurn) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 6}
 }
 
-func (x *GameState_Player) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type GameActivity_GameFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GameState_Player) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *GameActivity_GameFinished) Reset() {
+	*x = GameActivity_GameFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GameState_Player) GetCards() *GameState_Cards {
-	if x != nil {
-		return x.Cards
-	}
-	return nil
+func (x *GameActivity_GameFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameState_Player) GetNumCards() int32 {
-	if x != nil {
-		return x.NumCards
+func (*GameActivity_GameFinished) ProtoMessage() {}
+
+func (x *GameActivity_GameFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameState_Player) GetTricks() []*GameState_Cards {
-	if x != nil {
-		return x.Tricks
-	}
-	return nil
+// Deprecated: Use GameActivity_GameFinished.ProtoReflect.Descriptor instead.
+func (*GameActivity_GameFinished) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 7}
 }
 
-func (x *GameState_Player) GetNumTricks() int32 {
-	if x != nil {
-		return x.NumTricks
-	}
-	return 0
+type GameActivity_GameAborted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GameState_Player) GetTrickScore() int32 {
-	if x != nil {
-		return x.TrickScore
+func (x *GameActivity_GameAborted) Reset() {
+	*x = GameActivity_GameAborted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GameState_Player) GetIsNextPlayer() bool {
-	if x != nil {
-		return x.IsNextPlayer
-	}
-	return false
+func (x *GameActivity_GameAborted) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameState_Player) GetHandScore() int32 {
-	if x != nil {
-		return x.HandScore
+func (*GameActivity_GameAborted) ProtoMessage() {}
+
+func (x *GameActivity_GameAborted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type GameState_Cards struct {
+// Deprecated: Use GameActivity_GameAborted.ProtoReflect.Descriptor instead.
+func (*GameActivity_GameAborted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30, 8}
+}
+
+type RegistryActivity_SessionCreated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cards []string `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Secret for ResumeSession and RejoinGame. Don't share it with other players.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *GameState_Cards) Reset() {
-	*x = GameState_Cards{}
+func (x *RegistryActivity_SessionCreated) Reset() {
+	*x = RegistryActivity_SessionCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[21]
+		mi := &file_game_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameState_Cards) String() string {
+func (x *RegistryActivity_SessionCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState_Cards) ProtoMessage() {}
+func (*RegistryActivity_SessionCreated) ProtoMessage() {}
 
-func (x *GameState_Cards) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[21]
+func (x *RegistryActivity_SessionCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,43 +3779,50 @@ func (x *GameState_Cards) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState_Cards.ProtoReflect.Descriptor instead.
-func (*GameState_Cards) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13, 1}
+// Deprecated: Use RegistryActivity_SessionCreated.ProtoReflect.Descriptor instead.
+func (*RegistryActivity_SessionCreated) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (x *GameState_Cards) GetCards() []string {
+func (x *RegistryActivity_SessionCreated) GetSessionId() string {
 	if x != nil {
-		return x.Cards
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type GameActivity_PlayerJoined struct {
+func (x *RegistryActivity_SessionCreated) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type RegistryActivity_GameCreated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *GameActivity_PlayerJoined) Reset() {
-	*x = GameActivity_PlayerJoined{}
+func (x *RegistryActivity_GameCreated) Reset() {
+	*x = RegistryActivity_GameCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[22]
+		mi := &file_game_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_PlayerJoined) String() string {
+func (x *RegistryActivity_GameCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_PlayerJoined) ProtoMessage() {}
+func (*RegistryActivity_GameCreated) ProtoMessage() {}
 
-func (x *GameActivity_PlayerJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[22]
+func (x *RegistryActivity_GameCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,43 +3833,43 @@ func (x *GameActivity_PlayerJoined) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_PlayerJoined.ProtoReflect.Descriptor instead.
-func (*GameActivity_PlayerJoined) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use RegistryActivity_GameCreated.ProtoReflect.Descriptor instead.
+func (*RegistryActivity_GameCreated) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33, 1}
 }
 
-func (x *GameActivity_PlayerJoined) GetName() string {
+func (x *RegistryActivity_GameCreated) GetGameId() string {
 	if x != nil {
-		return x.Name
+		return x.GameId
 	}
 	return ""
 }
 
-type GameActivity_PlayerLeft struct {
+type RegistryActivity_GameDeleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *GameActivity_PlayerLeft) Reset() {
-	*x = GameActivity_PlayerLeft{}
+func (x *RegistryActivity_GameDeleted) Reset() {
+	*x = RegistryActivity_GameDeleted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[23]
+		mi := &file_game_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_PlayerLeft) String() string {
+func (x *RegistryActivity_GameDeleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_PlayerLeft) ProtoMessage() {}
+func (*RegistryActivity_GameDeleted) ProtoMessage() {}
 
-func (x *GameActivity_PlayerLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[23]
+func (x *RegistryActivity_GameDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,41 +3880,43 @@ func (x *GameActivity_PlayerLeft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_PlayerLeft.ProtoReflect.Descriptor instead.
-func (*GameActivity_PlayerLeft) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 1}
+// Deprecated: Use RegistryActivity_GameDeleted.ProtoReflect.Descriptor instead.
+func (*RegistryActivity_GameDeleted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33, 2}
 }
 
-func (x *GameActivity_PlayerLeft) GetName() string {
+func (x *RegistryActivity_GameDeleted) GetGameId() string {
 	if x != nil {
-		return x.Name
+		return x.GameId
 	}
 	return ""
 }
 
-type GameActivity_GameReadyToStart struct {
+type RegistryActivity_FullGamesList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GameIds []string `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
 }
 
-func (x *GameActivity_GameReadyToStart) Reset() {
-	*x = GameActivity_GameReadyToStart{}
+func (x *RegistryActivity_FullGamesList) Reset() {
+	*x = RegistryActivity_FullGamesList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[24]
+		mi := &file_game_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_GameReadyToStart) String() string {
+func (x *RegistryActivity_FullGamesList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_GameReadyToStart) ProtoMessage() {}
+func (*RegistryActivity_FullGamesList) ProtoMessage() {}
 
-func (x *GameActivity_GameReadyToStart) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[24]
+func (x *RegistryActivity_FullGamesList) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1634,34 +3927,47 @@ func (x *GameActivity_GameReadyToStart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_GameReadyToStart.ProtoReflect.Descriptor instead.
-func (*GameActivity_GameReadyToStart) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 2}
+// Deprecated: Use RegistryActivity_FullGamesList.ProtoReflect.Descriptor instead.
+func (*RegistryActivity_FullGamesList) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33, 3}
 }
 
-type GameActivity_GameStarted struct {
+func (x *RegistryActivity_FullGamesList) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type SavedGame_Session struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Activity sent to the session, so that it can rejoin where it left off.
+	Sent []*GameActivity `protobuf:"bytes,4,rep,name=sent,proto3" json:"sent,omitempty"`
 }
 
-func (x *GameActivity_GameStarted) Reset() {
-	*x = GameActivity_GameStarted{}
+func (x *SavedGame_Session) Reset() {
+	*x = SavedGame_Session{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[25]
+		mi := &file_game_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_GameStarted) String() string {
+func (x *SavedGame_Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_GameStarted) ProtoMessage() {}
+func (*SavedGame_Session) ProtoMessage() {}
 
-func (x *GameActivity_GameStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[25]
+func (x *SavedGame_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,34 +3978,66 @@ func (x *GameActivity_GameStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_GameStarted.ProtoReflect.Descriptor instead.
-func (*GameActivity_GameStarted) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 3}
+// Deprecated: Use SavedGame_Session.ProtoReflect.Descriptor instead.
+func (*SavedGame_Session) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{34, 0}
 }
 
-type GameActivity_CardPlayed struct {
+func (x *SavedGame_Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedGame_Session) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedGame_Session) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SavedGame_Session) GetSent() []*GameActivity {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+type GameHistory_Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsBot bool   `protobuf:"varint,3,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
 }
 
-func (x *GameActivity_CardPlayed) Reset() {
-	*x = GameActivity_CardPlayed{}
+func (x *GameHistory_Player) Reset() {
+	*x = GameHistory_Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[26]
+		mi := &file_game_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_CardPlayed) String() string {
+func (x *GameHistory_Player) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_CardPlayed) ProtoMessage() {}
+func (*GameHistory_Player) ProtoMessage() {}
 
-func (x *GameActivity_CardPlayed) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[26]
+func (x *GameHistory_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,39 +4048,64 @@ func (x *GameActivity_CardPlayed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_CardPlayed.ProtoReflect.Descriptor instead.
-func (*GameActivity_CardPlayed) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 4}
+// Deprecated: Use GameHistory_Player.ProtoReflect.Descriptor instead.
+func (*GameHistory_Player) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35, 0}
 }
 
-type GameActivity_TrickCompleted struct {
+func (x *GameHistory_Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameHistory_Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameHistory_Player) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+type GameHistory_Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trick       []string `protobuf:"bytes,1,rep,name=trick,proto3" json:"trick,omitempty"`
-	WinningCard string   `protobuf:"bytes,2,opt,name=winning_card,json=winningCard,proto3" json:"winning_card,omitempty"`
-	WinnerId    string   `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	WinnerName  string   `protobuf:"bytes,4,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // Unix milliseconds
+	// Types that are assignable to Type:
+	//	*GameHistory_Event_Deal
+	//	*GameHistory_Event_CardPlayed
+	//	*GameHistory_Event_TrickCompleted
+	//	*GameHistory_Event_GameFinished
+	//	*GameHistory_Event_GameAborted
+	Type isGameHistory_Event_Type `protobuf_oneof:"type"`
 }
 
-func (x *GameActivity_TrickCompleted) Reset() {
-	*x = GameActivity_TrickCompleted{}
+func (x *GameHistory_Event) Reset() {
+	*x = GameHistory_Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[27]
+		mi := &file_game_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_TrickCompleted) String() string {
+func (x *GameHistory_Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_TrickCompleted) ProtoMessage() {}
+func (*GameHistory_Event) ProtoMessage() {}
 
-func (x *GameActivity_TrickCompleted) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[27]
+func (x *GameHistory_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,62 +4116,119 @@ func (x *GameActivity_TrickCompleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_TrickCompleted.ProtoReflect.Descriptor instead.
-func (*GameActivity_TrickCompleted) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 5}
+// Deprecated: Use GameHistory_Event.ProtoReflect.Descriptor instead.
+func (*GameHistory_Event) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35, 1}
 }
 
-func (x *GameActivity_TrickCompleted) GetTrick() []string {
+func (x *GameHistory_Event) GetTime() int64 {
 	if x != nil {
-		return x.Trick
+		return x.Time
+	}
+	return 0
+}
+
+func (m *GameHistory_Event) GetType() isGameHistory_Event_Type {
+	if m != nil {
+		return m.Type
 	}
 	return nil
 }
 
-func (x *GameActivity_TrickCompleted) GetWinningCard() string {
-	if x != nil {
-		return x.WinningCard
+func (x *GameHistory_Event) GetDeal() *GameHistory_Deal {
+	if x, ok := x.GetType().(*GameHistory_Event_Deal); ok {
+		return x.Deal
 	}
-	return ""
+	return nil
 }
 
-func (x *GameActivity_TrickCompleted) GetWinnerId() string {
-	if x != nil {
-		return x.WinnerId
+func (x *GameHistory_Event) GetCardPlayed() *GameActivity_CardPlayed {
+	if x, ok := x.GetType().(*GameHistory_Event_CardPlayed); ok {
+		return x.CardPlayed
 	}
-	return ""
+	return nil
 }
 
-func (x *GameActivity_TrickCompleted) GetWinnerName() string {
-	if x != nil {
-		return x.WinnerName
+func (x *GameHistory_Event) GetTrickCompleted() *GameActivity_TrickCompleted {
+	if x, ok := x.GetType().(*GameHistory_Event_TrickCompleted); ok {
+		return x.TrickCompleted
 	}
-	return ""
+	return nil
 }
 
-type GameActivity_YourTurn struct {
+func (x *GameHistory_Event) GetGameFinished() *GameHistory_GameFinished {
+	if x, ok := x.GetType().(*GameHistory_Event_GameFinished); ok {
+		return x.GameFinished
+	}
+	return nil
+}
+
+func (x *GameHistory_Event) GetGameAborted() *GameHistory_GameAborted {
+	if x, ok := x.GetType().(*GameHistory_Event_GameAborted); ok {
+		return x.GameAborted
+	}
+	return nil
+}
+
+type isGameHistory_Event_Type interface {
+	isGameHistory_Event_Type()
+}
+
+type GameHistory_Event_Deal struct {
+	Deal *GameHistory_Deal `protobuf:"bytes,10,opt,name=deal,proto3,oneof"`
+}
+
+type GameHistory_Event_CardPlayed struct {
+	CardPlayed *GameActivity_CardPlayed `protobuf:"bytes,11,opt,name=card_played,json=cardPlayed,proto3,oneof"`
+}
+
+type GameHistory_Event_TrickCompleted struct {
+	TrickCompleted *GameActivity_TrickCompleted `protobuf:"bytes,12,opt,name=trick_completed,json=trickCompleted,proto3,oneof"`
+}
+
+type GameHistory_Event_GameFinished struct {
+	GameFinished *GameHistory_GameFinished `protobuf:"bytes,13,opt,name=game_finished,json=gameFinished,proto3,oneof"`
+}
+
+type GameHistory_Event_GameAborted struct {
+	GameAborted *GameHistory_GameAborted `protobuf:"bytes,14,opt,name=game_aborted,json=gameAborted,proto3,oneof"`
+}
+
+func (*GameHistory_Event_Deal) isGameHistory_Event_Type() {}
+
+func (*GameHistory_Event_CardPlayed) isGameHistory_Event_Type() {}
+
+func (*GameHistory_Event_TrickCompleted) isGameHistory_Event_Type() {}
+
+func (*GameHistory_Event_GameFinished) isGameHistory_Event_Type() {}
+
+func (*GameHistory_Event_GameAborted) isGameHistory_Event_Type() {}
+
+type GameHistory_Deal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Hands []*GameState_Cards `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"` // In the order of players.
 }
 
-func (x *GameActivity_YourTurn) Reset() {
-	*x = GameActivity_YourTurn{}
+func (x *GameHistory_Deal) Reset() {
+	*x = GameHistory_Deal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[28]
+		mi := &file_game_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_YourTurn) String() string {
+func (x *GameHistory_Deal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_YourTurn) ProtoMessage() {}
+func (*GameHistory_Deal) ProtoMessage() {}
 
-func (x *GameActivity_YourTurn) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[28]
+func (x *GameHistory_Deal) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,34 +4239,43 @@ func (x *GameActivity_YourTurn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_YourTurn.ProtoReflect.Descriptor instead.
-func (*GameActivity_YourTurn) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 6}
+// Deprecated: Use GameHistory_Deal.ProtoReflect.Descriptor instead.
+func (*GameHistory_Deal) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35, 2}
 }
 
-type GameActivity_GameFinished struct {
+func (x *GameHistory_Deal) GetHands() []*GameState_Cards {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+type GameHistory_GameFinished struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	HandScores []int32 `protobuf:"varint,1,rep,packed,name=hand_scores,json=handScores,proto3" json:"hand_scores,omitempty"` // In the order of players.
 }
 
-func (x *GameActivity_GameFinished) Reset() {
-	*x = GameActivity_GameFinished{}
+func (x *GameHistory_GameFinished) Reset() {
+	*x = GameHistory_GameFinished{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[29]
+		mi := &file_game_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_GameFinished) String() string {
+func (x *GameHistory_GameFinished) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_GameFinished) ProtoMessage() {}
+func (*GameHistory_GameFinished) ProtoMessage() {}
 
-func (x *GameActivity_GameFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[29]
+func (x *GameHistory_GameFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,34 +4286,41 @@ func (x *GameActivity_GameFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_GameFinished.ProtoReflect.Descriptor instead.
-func (*GameActivity_GameFinished) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 7}
+// Deprecated: Use GameHistory_GameFinished.ProtoReflect.Descriptor instead.
+func (*GameHistory_GameFinished) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35, 3}
 }
 
-type GameActivity_GameAborted struct {
+func (x *GameHistory_GameFinished) GetHandScores() []int32 {
+	if x != nil {
+		return x.HandScores
+	}
+	return nil
+}
+
+type GameHistory_GameAborted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GameActivity_GameAborted) Reset() {
-	*x = GameActivity_GameAborted{}
+func (x *GameHistory_GameAborted) Reset() {
+	*x = GameHistory_GameAborted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[30]
+		mi := &file_game_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameActivity_GameAborted) String() string {
+func (x *GameHistory_GameAborted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameActivity_GameAborted) ProtoMessage() {}
+func (*GameHistory_GameAborted) ProtoMessage() {}
 
-func (x *GameActivity_GameAborted) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[30]
+func (x *GameHistory_GameAborted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,36 +4331,44 @@ func (x *GameActivity_GameAborted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameActivity_GameAborted.ProtoReflect.Descriptor instead.
-func (*GameActivity_GameAborted) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15, 8}
+// Deprecated: Use GameHistory_GameAborted.ProtoReflect.Descriptor instead.
+func (*GameHistory_GameAborted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35, 4}
 }
 
-type RegistryActivity_SessionCreated struct {
+type GameAnalysis_Decision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Play               int32                       `protobuf:"varint,1,opt,name=play,proto3" json:"play,omitempty"` // Counting from 1.
+	Seat               string                      `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`  // N, E, S or W, in turn order from the first player.
+	Name               string                      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Card               string                      `protobuf:"bytes,4,opt,name=card,proto3" json:"card,omitempty"`
+	ExpectedPoints     float64                     `protobuf:"fixed64,5,opt,name=expected_points,json=expectedPoints,proto3" json:"expected_points,omitempty"` // Expected points taken for the rest of the hand after playing card.
+	BestCard           string                      `protobuf:"bytes,6,opt,name=best_card,json=bestCard,proto3" json:"best_card,omitempty"`
+	BestExpectedPoints float64                     `protobuf:"fixed64,7,opt,name=best_expected_points,json=bestExpectedPoints,proto3" json:"best_expected_points,omitempty"`
+	Grade              GameAnalysis_Decision_Grade `protobuf:"varint,8,opt,name=grade,proto3,enum=cards.proto.GameAnalysis_Decision_Grade" json:"grade,omitempty"`
+	Comment            string                      `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"` // e.g. "took Q♠ with K♠ when 7♠ was safe"
 }
 
-func (x *RegistryActivity_SessionCreated) Reset() {
-	*x = RegistryActivity_SessionCreated{}
+func (x *GameAnalysis_Decision) Reset() {
+	*x = GameAnalysis_Decision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[31]
+		mi := &file_game_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryActivity_SessionCreated) String() string {
+func (x *GameAnalysis_Decision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryActivity_SessionCreated) ProtoMessage() {}
+func (*GameAnalysis_Decision) ProtoMessage() {}
 
-func (x *RegistryActivity_SessionCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[31]
+func (x *GameAnalysis_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1935,90 +4379,105 @@ func (x *RegistryActivity_SessionCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryActivity_SessionCreated.ProtoReflect.Descriptor instead.
-func (*RegistryActivity_SessionCreated) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use GameAnalysis_Decision.ProtoReflect.Descriptor instead.
+func (*GameAnalysis_Decision) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36, 0}
 }
 
-func (x *RegistryActivity_SessionCreated) GetSessionId() string {
+func (x *GameAnalysis_Decision) GetPlay() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.Play
 	}
-	return ""
+	return 0
 }
 
-type RegistryActivity_GameCreated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+func (x *GameAnalysis_Decision) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
 }
 
-func (x *RegistryActivity_GameCreated) Reset() {
-	*x = RegistryActivity_GameCreated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GameAnalysis_Decision) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *RegistryActivity_GameCreated) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GameAnalysis_Decision) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
 }
 
-func (*RegistryActivity_GameCreated) ProtoMessage() {}
+func (x *GameAnalysis_Decision) GetExpectedPoints() float64 {
+	if x != nil {
+		return x.ExpectedPoints
+	}
+	return 0
+}
 
-func (x *RegistryActivity_GameCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GameAnalysis_Decision) GetBestCard() string {
+	if x != nil {
+		return x.BestCard
+	}
+	return ""
+}
+
+func (x *GameAnalysis_Decision) GetBestExpectedPoints() float64 {
+	if x != nil {
+		return x.BestExpectedPoints
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RegistryActivity_GameCreated.ProtoReflect.Descriptor instead.
-func (*RegistryActivity_GameCreated) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 1}
+func (x *GameAnalysis_Decision) GetGrade() GameAnalysis_Decision_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GameAnalysis_Decision_Good
 }
 
-func (x *RegistryActivity_GameCreated) GetGameId() string {
+func (x *GameAnalysis_Decision) GetComment() string {
 	if x != nil {
-		return x.GameId
+		return x.Comment
 	}
 	return ""
 }
 
-type RegistryActivity_GameDeleted struct {
+type GameAnalysis_PlayerSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Seat         string  `protobuf:"bytes,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Decisions    int32   `protobuf:"varint,3,opt,name=decisions,proto3" json:"decisions,omitempty"`
+	Inaccuracies int32   `protobuf:"varint,4,opt,name=inaccuracies,proto3" json:"inaccuracies,omitempty"`
+	Blunders     int32   `protobuf:"varint,5,opt,name=blunders,proto3" json:"blunders,omitempty"`
+	AverageLoss  float64 `protobuf:"fixed64,6,opt,name=average_loss,json=averageLoss,proto3" json:"average_loss,omitempty"` // Expected points lost per decision, compared with the best play.
+	Accuracy     float64 `protobuf:"fixed64,7,opt,name=accuracy,proto3" json:"accuracy,omitempty"`                          // Percentage of decisions that weren't inaccuracies or blunders.
 }
 
-func (x *RegistryActivity_GameDeleted) Reset() {
-	*x = RegistryActivity_GameDeleted{}
+func (x *GameAnalysis_PlayerSummary) Reset() {
+	*x = GameAnalysis_PlayerSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[33]
+		mi := &file_game_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryActivity_GameDeleted) String() string {
+func (x *GameAnalysis_PlayerSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryActivity_GameDeleted) ProtoMessage() {}
+func (*GameAnalysis_PlayerSummary) ProtoMessage() {}
 
-func (x *RegistryActivity_GameDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[33]
+func (x *GameAnalysis_PlayerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,43 +4488,87 @@ func (x *RegistryActivity_GameDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryActivity_GameDeleted.ProtoReflect.Descriptor instead.
-func (*RegistryActivity_GameDeleted) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 2}
+// Deprecated: Use GameAnalysis_PlayerSummary.ProtoReflect.Descriptor instead.
+func (*GameAnalysis_PlayerSummary) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36, 1}
 }
 
-func (x *RegistryActivity_GameDeleted) GetGameId() string {
+func (x *GameAnalysis_PlayerSummary) GetSeat() string {
 	if x != nil {
-		return x.GameId
+		return x.Seat
 	}
 	return ""
 }
 
-type RegistryActivity_FullGamesList struct {
+func (x *GameAnalysis_PlayerSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameAnalysis_PlayerSummary) GetDecisions() int32 {
+	if x != nil {
+		return x.Decisions
+	}
+	return 0
+}
+
+func (x *GameAnalysis_PlayerSummary) GetInaccuracies() int32 {
+	if x != nil {
+		return x.Inaccuracies
+	}
+	return 0
+}
+
+func (x *GameAnalysis_PlayerSummary) GetBlunders() int32 {
+	if x != nil {
+		return x.Blunders
+	}
+	return 0
+}
+
+func (x *GameAnalysis_PlayerSummary) GetAverageLoss() float64 {
+	if x != nil {
+		return x.AverageLoss
+	}
+	return 0
+}
+
+func (x *GameAnalysis_PlayerSummary) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type Ratings_Rating struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameIds []string `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rating float64 `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Games  int32   `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"` // Completed games the rating is based on.
 }
 
-func (x *RegistryActivity_FullGamesList) Reset() {
-	*x = RegistryActivity_FullGamesList{}
+func (x *Ratings_Rating) Reset() {
+	*x = Ratings_Rating{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[34]
+		mi := &file_game_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryActivity_FullGamesList) String() string {
+func (x *Ratings_Rating) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryActivity_FullGamesList) ProtoMessage() {}
+func (*Ratings_Rating) ProtoMessage() {}
 
-func (x *RegistryActivity_FullGamesList) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[34]
+func (x *Ratings_Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,16 +4579,30 @@ func (x *RegistryActivity_FullGamesList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryActivity_FullGamesList.ProtoReflect.Descriptor instead.
-func (*RegistryActivity_FullGamesList) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 3}
+// Deprecated: Use Ratings_Rating.ProtoReflect.Descriptor instead.
+func (*Ratings_Rating) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{38, 0}
 }
 
-func (x *RegistryActivity_FullGamesList) GetGameIds() []string {
+func (x *Ratings_Rating) GetName() string {
 	if x != nil {
-		return x.GameIds
+		return x.Name
 	}
-	return nil
+	return ""
+}
+
+func (x *Ratings_Rating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Ratings_Rating) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
 }
 
 var File_game_proto protoreflect.FileDescriptor
@@ -2098,66 +4615,215 @@ var file_game_proto_rawDesc = []byte{
 	0x22, 0x31, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
-	0x49, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x68,
-	0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x6d,
-	0x65, 0x73, 0x1a, 0x74, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05,
-	0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x6e, 0x49, 0x64, 0x22, 0xd6, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x68, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x25, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x10, 0x01, 0x22, 0x2d, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x0f, 0x4a,
+	0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a,
 	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
-	0x6d, 0x65, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x6f,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54,
-	0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6c, 0x65,
-	0x61, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x43,
-	0x61, 0x72, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6c, 0x61,
-	0x79, 0x43, 0x61, 0x72, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x18, 0x0a,
-	0x16, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x0e, 0x50, 0x6c,
-	0x61, 0x79, 0x43, 0x61, 0x72, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
-	0x22, 0x4a, 0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x69, 0x0a, 0x12, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x6f,
+	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x61,
+	0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22,
+	0xa9, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x73,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0xcc, 0x01, 0x0a,
+	0x08, 0x50, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x10,
+	0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x67, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x61, 0x70, 0x22, 0x8b, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x77, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x48,
+	0x00, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0d, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x1a, 0x39, 0x0a, 0x07, 0x57, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x1a, 0x27, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x42, 0x06,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdc, 0x01, 0x0a,
+	0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x0a, 0x07,
+	0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x6f, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x04, 0x22, 0x41, 0x0a, 0x0b, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x9d,
+	0x04, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
 	0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xb2, 0x05, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x13,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x72, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x42, 0x6f, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x64, 0x64,
+	0x42, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x6f,
+	0x73, 0x65, 0x53, 0x65, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x18,
+	0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x0e, 0x50,
+	0x6c, 0x61, 0x79, 0x43, 0x61, 0x72, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x72,
+	0x64, 0x22, 0x2f, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x0f, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x43,
+	0x0a, 0x10, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x61, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x22, 0x67, 0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xe4, 0x06, 0x0a,
 	0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68,
 	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64,
@@ -2174,7 +4840,7 @@ var file_game_proto_rawDesc = []byte{
 	0x67, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61,
 	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x0a, 0x6c,
-	0x65, 0x67, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0xb8, 0x02, 0x0a, 0x06, 0x50, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0xac, 0x03, 0x0a, 0x06, 0x50, 0x6c,
 	0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64,
@@ -2194,164 +4860,384 @@ var file_game_proto_rawDesc = []byte{
 	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50,
 	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63,
 	0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x1a, 0x1d, 0x0a, 0x05, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79,
-	0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x10,
-	0x04, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbf, 0x08, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
-	0x4d, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x42, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x1a, 0x1d, 0x0a, 0x05, 0x43, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x10, 0x04, 0x22, 0x3c, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x6f, 0x53, 0x65, 0x61, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x6f, 0x72,
+	0x74, 0x68, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x61, 0x73, 0x74, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65, 0x73,
+	0x74, 0x10, 0x04, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x91, 0x09, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6a, 0x6f, 0x69, 0x6e,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x12, 0x47, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x5b, 0x0a, 0x13, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00,
-	0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x47,
-	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x5b, 0x0a, 0x13, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54,
+	0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0a, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0f, 0x74,
+	0x72, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x48, 0x00, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72,
+	0x54, 0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x41, 0x0a, 0x09, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x59,
+	0x6f, 0x75, 0x72, 0x54, 0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x79, 0x6f, 0x75, 0x72, 0x54,
+	0x75, 0x72, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x72,
 	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x12, 0x47, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x63,
-	0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0f, 0x74, 0x72, 0x69,
-	0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x72,
-	0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e,
-	0x74, 0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x41,
-	0x0a, 0x09, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x59, 0x6f, 0x75,
-	0x72, 0x54, 0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x79, 0x6f, 0x75, 0x72, 0x54, 0x75, 0x72,
-	0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x48, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
-	0x0b, 0x67, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0d,
-	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
-	0x4d, 0x73, 0x67, 0x1a, 0x22, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69,
-	0x6e, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x20, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x12, 0x0a, 0x10, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x0d, 0x0a,
-	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x0c, 0x0a, 0x0a,
-	0x43, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x1a, 0x87, 0x01, 0x0a, 0x0e, 0x54,
-	0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72,
-	0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0a, 0x0a, 0x08, 0x59, 0x6f, 0x75, 0x72, 0x54, 0x75, 0x72, 0x6e,
-	0x1a, 0x0e, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x1a, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42,
-	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x97, 0x04, 0x0a, 0x10, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
-	0x57, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d,
-	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c,
-	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x1a,
-	0x2f, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x1a, 0x26, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x1a, 0x26, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
-	0x1a, 0x2a, 0x0a, 0x0d, 0x46, 0x75, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x42, 0x06, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x32, 0xd2, 0x04, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x47, 0x61, 0x6d,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67,
-	0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72,
-	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x30, 0x01,
-	0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
-	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61,
-	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x4a,
-	0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x47, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12,
-	0x41, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e,
+	0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0d, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x22, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a,
+	0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x20, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x12, 0x0a, 0x10, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a,
+	0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x5e,
+	0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x87,
+	0x01, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0a, 0x0a, 0x08, 0x59, 0x6f, 0x75, 0x72,
+	0x54, 0x75, 0x72, 0x6e, 0x1a, 0x0e, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x1a, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xba,
+	0x04, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0c,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x61, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0c,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x0f,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x1a, 0x52, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x26, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x1a,
+	0x26, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x1a, 0x2a, 0x0a, 0x0d, 0x46, 0x75, 0x6c, 0x6c, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x95, 0x05, 0x0a, 0x09,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x6c, 0x65, 0x66,
+	0x74, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x65, 0x66, 0x74, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x1a, 0x7f, 0x0a, 0x07, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x4f, 0x63,
+	0x63, 0x75, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x65, 0x66, 0x74,
+	0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xa0, 0x06, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0x43, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x69, 0x73, 0x42, 0x6f, 0x74, 0x1a, 0x8f, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44,
+	0x65, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x0b, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x72, 0x69, 0x63, 0x6b, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x63, 0x6b,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0d, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x70, 0x73, 0x61, 0x6c, 0x69, 0x73, 0x62,
-	0x75, 0x72, 0x79, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x3a, 0x0a, 0x04, 0x44, 0x65,
+	0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x1a, 0x2f, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x61, 0x6e,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xe2, 0x05, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x40, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0xdc, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61,
+	0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x62, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x47, 0x6f, 0x6f, 0x64, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e, 0x61, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x6c, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x10, 0x02, 0x1a, 0xd4, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x6e, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x62, 0x6c, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x73, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x22, 0xec, 0x01, 0x0a, 0x0b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x6f, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x12,
+	0x31, 0x0a, 0x15, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x70, 0x61, 0x64,
+	0x65, 0x73, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x71, 0x75, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x53, 0x70, 0x61, 0x64, 0x65, 0x73, 0x54, 0x61, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x4a, 0x0a,
+	0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x32, 0xc6, 0x09, 0x0a, 0x0f, 0x43, 0x61,
+	0x72, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0b, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x53,
+	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0x4b,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x4b,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x30, 0x01, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x70, 0x73, 0x61, 0x6c, 0x69, 0x73, 0x62, 0x75, 0x72, 0x79, 0x2f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2366,93 +5252,179 @@ func file_game_proto_rawDescGZIP() []byte {
 	return file_game_proto_rawDescData
 }
 
-var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_game_proto_goTypes = []interface{}{
-	(GameState_Phase)(0),                    // 0: cards.proto.GameState.Phase
-	(*RegisterRequest)(nil),                 // 1: cards.proto.RegisterRequest
-	(*RegisterResponse)(nil),                // 2: cards.proto.RegisterResponse
-	(*CreateGameRequest)(nil),               // 3: cards.proto.CreateGameRequest
-	(*CreateGameResponse)(nil),              // 4: cards.proto.CreateGameResponse
-	(*JoinGameRequest)(nil),                 // 5: cards.proto.JoinGameRequest
-	(*ListGamesRequest)(nil),                // 6: cards.proto.ListGamesRequest
-	(*ListGamesResponse)(nil),               // 7: cards.proto.ListGamesResponse
-	(*ObserveGameRequest)(nil),              // 8: cards.proto.ObserveGameRequest
-	(*GameActionRequest)(nil),               // 9: cards.proto.GameActionRequest
-	(*ReadyToStartGameAction)(nil),          // 10: cards.proto.ReadyToStartGameAction
-	(*LeaveGameAction)(nil),                 // 11: cards.proto.LeaveGameAction
-	(*PlayCardAction)(nil),                  // 12: cards.proto.PlayCardAction
-	(*GameStateRequest)(nil),                // 13: cards.proto.GameStateRequest
-	(*GameState)(nil),                       // 14: cards.proto.GameState
-	(*Status)(nil),                          // 15: cards.proto.Status
-	(*GameActivity)(nil),                    // 16: cards.proto.GameActivity
-	(*PingRequest)(nil),                     // 17: cards.proto.PingRequest
-	(*PingResponse)(nil),                    // 18: cards.proto.PingResponse
-	(*RegistryActivity)(nil),                // 19: cards.proto.RegistryActivity
-	(*ListGamesResponse_GameSummary)(nil),   // 20: cards.proto.ListGamesResponse.GameSummary
-	(*GameState_Player)(nil),                // 21: cards.proto.GameState.Player
-	(*GameState_Cards)(nil),                 // 22: cards.proto.GameState.Cards
-	(*GameActivity_PlayerJoined)(nil),       // 23: cards.proto.GameActivity.PlayerJoined
-	(*GameActivity_PlayerLeft)(nil),         // 24: cards.proto.GameActivity.PlayerLeft
-	(*GameActivity_GameReadyToStart)(nil),   // 25: cards.proto.GameActivity.GameReadyToStart
-	(*GameActivity_GameStarted)(nil),        // 26: cards.proto.GameActivity.GameStarted
-	(*GameActivity_CardPlayed)(nil),         // 27: cards.proto.GameActivity.CardPlayed
-	(*GameActivity_TrickCompleted)(nil),     // 28: cards.proto.GameActivity.TrickCompleted
-	(*GameActivity_YourTurn)(nil),           // 29: cards.proto.GameActivity.YourTurn
-	(*GameActivity_GameFinished)(nil),       // 30: cards.proto.GameActivity.GameFinished
-	(*GameActivity_GameAborted)(nil),        // 31: cards.proto.GameActivity.GameAborted
-	(*RegistryActivity_SessionCreated)(nil), // 32: cards.proto.RegistryActivity.SessionCreated
-	(*RegistryActivity_GameCreated)(nil),    // 33: cards.proto.RegistryActivity.GameCreated
-	(*RegistryActivity_GameDeleted)(nil),    // 34: cards.proto.RegistryActivity.GameDeleted
-	(*RegistryActivity_FullGamesList)(nil),  // 35: cards.proto.RegistryActivity.FullGamesList
+	(CreateGameRequest_Visibility)(0),       // 0: cards.proto.CreateGameRequest.Visibility
+	(LeaderboardRequest_Order)(0),           // 1: cards.proto.LeaderboardRequest.Order
+	(GameState_Phase)(0),                    // 2: cards.proto.GameState.Phase
+	(GameState_Seat)(0),                     // 3: cards.proto.GameState.Seat
+	(GameAnalysis_Decision_Grade)(0),        // 4: cards.proto.GameAnalysis.Decision.Grade
+	(*RegisterRequest)(nil),                 // 5: cards.proto.RegisterRequest
+	(*RegisterResponse)(nil),                // 6: cards.proto.RegisterResponse
+	(*CreateGameRequest)(nil),               // 7: cards.proto.CreateGameRequest
+	(*CreateGameResponse)(nil),              // 8: cards.proto.CreateGameResponse
+	(*JoinGameRequest)(nil),                 // 9: cards.proto.JoinGameRequest
+	(*ListGamesRequest)(nil),                // 10: cards.proto.ListGamesRequest
+	(*ListGamesResponse)(nil),               // 11: cards.proto.ListGamesResponse
+	(*ObserveGameRequest)(nil),              // 12: cards.proto.ObserveGameRequest
+	(*ResumeSessionRequest)(nil),            // 13: cards.proto.ResumeSessionRequest
+	(*RejoinGameRequest)(nil),               // 14: cards.proto.RejoinGameRequest
+	(*GameHistoryRequest)(nil),              // 15: cards.proto.GameHistoryRequest
+	(*ListPastGamesRequest)(nil),            // 16: cards.proto.ListPastGamesRequest
+	(*ListPastGamesResponse)(nil),           // 17: cards.proto.ListPastGamesResponse
+	(*JoinQueueRequest)(nil),                // 18: cards.proto.JoinQueueRequest
+	(*QueueActivity)(nil),                   // 19: cards.proto.QueueActivity
+	(*AnalyzeGameRequest)(nil),              // 20: cards.proto.AnalyzeGameRequest
+	(*PlayerStatsRequest)(nil),              // 21: cards.proto.PlayerStatsRequest
+	(*LeaderboardRequest)(nil),              // 22: cards.proto.LeaderboardRequest
+	(*Leaderboard)(nil),                     // 23: cards.proto.Leaderboard
+	(*GameActionRequest)(nil),               // 24: cards.proto.GameActionRequest
+	(*ReadyToStartGameAction)(nil),          // 25: cards.proto.ReadyToStartGameAction
+	(*LeaveGameAction)(nil),                 // 26: cards.proto.LeaveGameAction
+	(*PlayCardAction)(nil),                  // 27: cards.proto.PlayCardAction
+	(*AddBotAction)(nil),                    // 28: cards.proto.AddBotAction
+	(*KickPlayerAction)(nil),                // 29: cards.proto.KickPlayerAction
+	(*StartGameAction)(nil),                 // 30: cards.proto.StartGameAction
+	(*ChooseSeatAction)(nil),                // 31: cards.proto.ChooseSeatAction
+	(*GameStateRequest)(nil),                // 32: cards.proto.GameStateRequest
+	(*GameState)(nil),                       // 33: cards.proto.GameState
+	(*Status)(nil),                          // 34: cards.proto.Status
+	(*GameActivity)(nil),                    // 35: cards.proto.GameActivity
+	(*PingRequest)(nil),                     // 36: cards.proto.PingRequest
+	(*PingResponse)(nil),                    // 37: cards.proto.PingResponse
+	(*RegistryActivity)(nil),                // 38: cards.proto.RegistryActivity
+	(*SavedGame)(nil),                       // 39: cards.proto.SavedGame
+	(*GameHistory)(nil),                     // 40: cards.proto.GameHistory
+	(*GameAnalysis)(nil),                    // 41: cards.proto.GameAnalysis
+	(*PlayerStats)(nil),                     // 42: cards.proto.PlayerStats
+	(*Ratings)(nil),                         // 43: cards.proto.Ratings
+	(*ListGamesResponse_GameSummary)(nil),   // 44: cards.proto.ListGamesResponse.GameSummary
+	(*ListPastGamesResponse_PastGame)(nil),  // 45: cards.proto.ListPastGamesResponse.PastGame
+	(*QueueActivity_Waiting)(nil),           // 46: cards.proto.QueueActivity.Waiting
+	(*QueueActivity_GameAssigned)(nil),      // 47: cards.proto.QueueActivity.GameAssigned
+	(*GameState_Player)(nil),                // 48: cards.proto.GameState.Player
+	(*GameState_Cards)(nil),                 // 49: cards.proto.GameState.Cards
+	(*GameActivity_PlayerJoined)(nil),       // 50: cards.proto.GameActivity.PlayerJoined
+	(*GameActivity_PlayerLeft)(nil),         // 51: cards.proto.GameActivity.PlayerLeft
+	(*GameActivity_GameReadyToStart)(nil),   // 52: cards.proto.GameActivity.GameReadyToStart
+	(*GameActivity_GameStarted)(nil),        // 53: cards.proto.GameActivity.GameStarted
+	(*GameActivity_CardPlayed)(nil),         // 54: cards.proto.GameActivity.CardPlayed
+	(*GameActivity_TrickCompleted)(nil),     // 55: cards.proto.GameActivity.TrickCompleted
+	(*GameActivity_YourTurn)(nil),           // 56: cards.proto.GameActivity.YourTurn
+	(*GameActivity_GameFinished)(nil),       // 57: cards.proto.GameActivity.GameFinished
+	(*GameActivity_GameAborted)(nil),        // 58: cards.proto.GameActivity.GameAborted
+	(*RegistryActivity_SessionCreated)(nil), // 59: cards.proto.RegistryActivity.SessionCreated
+	(*RegistryActivity_GameCreated)(nil),    // 60: cards.proto.RegistryActivity.GameCreated
+	(*RegistryActivity_GameDeleted)(nil),    // 61: cards.proto.RegistryActivity.GameDeleted
+	(*RegistryActivity_FullGamesList)(nil),  // 62: cards.proto.RegistryActivity.FullGamesList
+	(*SavedGame_Session)(nil),               // 63: cards.proto.SavedGame.Session
+	nil,                                     // 64: cards.proto.SavedGame.OccupantsEntry
+	nil,                                     // 65: cards.proto.SavedGame.LeftByEntry
+	(*GameHistory_Player)(nil),              // 66: cards.proto.GameHistory.Player
+	(*GameHistory_Event)(nil),               // 67: cards.proto.GameHistory.Event
+	(*GameHistory_Deal)(nil),                // 68: cards.proto.GameHistory.Deal
+	(*GameHistory_GameFinished)(nil),        // 69: cards.proto.GameHistory.GameFinished
+	(*GameHistory_GameAborted)(nil),         // 70: cards.proto.GameHistory.GameAborted
+	(*GameAnalysis_Decision)(nil),           // 71: cards.proto.GameAnalysis.Decision
+	(*GameAnalysis_PlayerSummary)(nil),      // 72: cards.proto.GameAnalysis.PlayerSummary
+	(*Ratings_Rating)(nil),                  // 73: cards.proto.Ratings.Rating
 }
 var file_game_proto_depIdxs = []int32{
-	0,  // 0: cards.proto.ListGamesRequest.phase:type_name -> cards.proto.GameState.Phase
-	20, // 1: cards.proto.ListGamesResponse.games:type_name -> cards.proto.ListGamesResponse.GameSummary
-	10, // 2: cards.proto.GameActionRequest.ready_to_start_game:type_name -> cards.proto.ReadyToStartGameAction
-	11, // 3: cards.proto.GameActionRequest.leave_game:type_name -> cards.proto.LeaveGameAction
-	12, // 4: cards.proto.GameActionRequest.play_card:type_name -> cards.proto.PlayCardAction
-	0,  // 5: cards.proto.GameState.phase:type_name -> cards.proto.GameState.Phase
-	21, // 6: cards.proto.GameState.players:type_name -> cards.proto.GameState.Player
-	22, // 7: cards.proto.GameState.current_trick:type_name -> cards.proto.GameState.Cards
-	22, // 8: cards.proto.GameState.legal_plays:type_name -> cards.proto.GameState.Cards
-	23, // 9: cards.proto.GameActivity.player_joined:type_name -> cards.proto.GameActivity.PlayerJoined
-	24, // 10: cards.proto.GameActivity.player_left:type_name -> cards.proto.GameActivity.PlayerLeft
-	25, // 11: cards.proto.GameActivity.game_ready_to_start:type_name -> cards.proto.GameActivity.GameReadyToStart
-	26, // 12: cards.proto.GameActivity.game_started:type_name -> cards.proto.GameActivity.GameStarted
-	27, // 13: cards.proto.GameActivity.card_played:type_name -> cards.proto.GameActivity.CardPlayed
-	28, // 14: cards.proto.GameActivity.trick_completed:type_name -> cards.proto.GameActivity.TrickCompleted
-	29, // 15: cards.proto.GameActivity.your_turn:type_name -> cards.proto.GameActivity.YourTurn
-	30, // 16: cards.proto.GameActivity.game_finished:type_name -> cards.proto.GameActivity.GameFinished
-	31, // 17: cards.proto.GameActivity.game_aborted:type_name -> cards.proto.GameActivity.GameAborted
-	32, // 18: cards.proto.RegistryActivity.session_created:type_name -> cards.proto.RegistryActivity.SessionCreated
-	33, // 19: cards.proto.RegistryActivity.game_created:type_name -> cards.proto.RegistryActivity.GameCreated
-	34, // 20: cards.proto.RegistryActivity.game_deleted:type_name -> cards.proto.RegistryActivity.GameDeleted
-	35, // 21: cards.proto.RegistryActivity.full_games_list:type_name -> cards.proto.RegistryActivity.FullGamesList
-	0,  // 22: cards.proto.ListGamesResponse.GameSummary.phase:type_name -> cards.proto.GameState.Phase
-	22, // 23: cards.proto.GameState.Player.cards:type_name -> cards.proto.GameState.Cards
-	22, // 24: cards.proto.GameState.Player.tricks:type_name -> cards.proto.GameState.Cards
-	17, // 25: cards.proto.CardGameService.Ping:input_type -> cards.proto.PingRequest
-	1,  // 26: cards.proto.CardGameService.Register:input_type -> cards.proto.RegisterRequest
-	3,  // 27: cards.proto.CardGameService.CreateGame:input_type -> cards.proto.CreateGameRequest
-	6,  // 28: cards.proto.CardGameService.ListGames:input_type -> cards.proto.ListGamesRequest
-	5,  // 29: cards.proto.CardGameService.JoinGame:input_type -> cards.proto.JoinGameRequest
-	8,  // 30: cards.proto.CardGameService.ObserveGame:input_type -> cards.proto.ObserveGameRequest
-	9,  // 31: cards.proto.CardGameService.GameAction:input_type -> cards.proto.GameActionRequest
-	13, // 32: cards.proto.CardGameService.GetGameState:input_type -> cards.proto.GameStateRequest
-	18, // 33: cards.proto.CardGameService.Ping:output_type -> cards.proto.PingResponse
-	19, // 34: cards.proto.CardGameService.Register:output_type -> cards.proto.RegistryActivity
-	4,  // 35: cards.proto.CardGameService.CreateGame:output_type -> cards.proto.CreateGameResponse
-	7,  // 36: cards.proto.CardGameService.ListGames:output_type -> cards.proto.ListGamesResponse
-	16, // 37: cards.proto.CardGameService.JoinGame:output_type -> cards.proto.GameActivity
-	16, // 38: cards.proto.CardGameService.ObserveGame:output_type -> cards.proto.GameActivity
-	15, // 39: cards.proto.CardGameService.GameAction:output_type -> cards.proto.Status
-	14, // 40: cards.proto.CardGameService.GetGameState:output_type -> cards.proto.GameState
-	33, // [33:41] is the sub-list for method output_type
-	25, // [25:33] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	49, // 0: cards.proto.CreateGameRequest.hands:type_name -> cards.proto.GameState.Cards
+	0,  // 1: cards.proto.CreateGameRequest.visibility:type_name -> cards.proto.CreateGameRequest.Visibility
+	2,  // 2: cards.proto.ListGamesRequest.phase:type_name -> cards.proto.GameState.Phase
+	44, // 3: cards.proto.ListGamesResponse.games:type_name -> cards.proto.ListGamesResponse.GameSummary
+	45, // 4: cards.proto.ListPastGamesResponse.games:type_name -> cards.proto.ListPastGamesResponse.PastGame
+	46, // 5: cards.proto.QueueActivity.waiting:type_name -> cards.proto.QueueActivity.Waiting
+	47, // 6: cards.proto.QueueActivity.game_assigned:type_name -> cards.proto.QueueActivity.GameAssigned
+	1,  // 7: cards.proto.LeaderboardRequest.order:type_name -> cards.proto.LeaderboardRequest.Order
+	42, // 8: cards.proto.Leaderboard.players:type_name -> cards.proto.PlayerStats
+	25, // 9: cards.proto.GameActionRequest.ready_to_start_game:type_name -> cards.proto.ReadyToStartGameAction
+	26, // 10: cards.proto.GameActionRequest.leave_game:type_name -> cards.proto.LeaveGameAction
+	27, // 11: cards.proto.GameActionRequest.play_card:type_name -> cards.proto.PlayCardAction
+	28, // 12: cards.proto.GameActionRequest.add_bot:type_name -> cards.proto.AddBotAction
+	29, // 13: cards.proto.GameActionRequest.kick_player:type_name -> cards.proto.KickPlayerAction
+	30, // 14: cards.proto.GameActionRequest.start_game:type_name -> cards.proto.StartGameAction
+	31, // 15: cards.proto.GameActionRequest.choose_seat:type_name -> cards.proto.ChooseSeatAction
+	3,  // 16: cards.proto.ChooseSeatAction.seat:type_name -> cards.proto.GameState.Seat
+	2,  // 17: cards.proto.GameState.phase:type_name -> cards.proto.GameState.Phase
+	48, // 18: cards.proto.GameState.players:type_name -> cards.proto.GameState.Player
+	49, // 19: cards.proto.GameState.current_trick:type_name -> cards.proto.GameState.Cards
+	49, // 20: cards.proto.GameState.legal_plays:type_name -> cards.proto.GameState.Cards
+	50, // 21: cards.proto.GameActivity.player_joined:type_name -> cards.proto.GameActivity.PlayerJoined
+	51, // 22: cards.proto.GameActivity.player_left:type_name -> cards.proto.GameActivity.PlayerLeft
+	52, // 23: cards.proto.GameActivity.game_ready_to_start:type_name -> cards.proto.GameActivity.GameReadyToStart
+	53, // 24: cards.proto.GameActivity.game_started:type_name -> cards.proto.GameActivity.GameStarted
+	54, // 25: cards.proto.GameActivity.card_played:type_name -> cards.proto.GameActivity.CardPlayed
+	55, // 26: cards.proto.GameActivity.trick_completed:type_name -> cards.proto.GameActivity.TrickCompleted
+	56, // 27: cards.proto.GameActivity.your_turn:type_name -> cards.proto.GameActivity.YourTurn
+	57, // 28: cards.proto.GameActivity.game_finished:type_name -> cards.proto.GameActivity.GameFinished
+	58, // 29: cards.proto.GameActivity.game_aborted:type_name -> cards.proto.GameActivity.GameAborted
+	59, // 30: cards.proto.RegistryActivity.session_created:type_name -> cards.proto.RegistryActivity.SessionCreated
+	60, // 31: cards.proto.RegistryActivity.game_created:type_name -> cards.proto.RegistryActivity.GameCreated
+	61, // 32: cards.proto.RegistryActivity.game_deleted:type_name -> cards.proto.RegistryActivity.GameDeleted
+	62, // 33: cards.proto.RegistryActivity.full_games_list:type_name -> cards.proto.RegistryActivity.FullGamesList
+	63, // 34: cards.proto.SavedGame.sessions:type_name -> cards.proto.SavedGame.Session
+	64, // 35: cards.proto.SavedGame.occupants:type_name -> cards.proto.SavedGame.OccupantsEntry
+	65, // 36: cards.proto.SavedGame.left_by:type_name -> cards.proto.SavedGame.LeftByEntry
+	40, // 37: cards.proto.SavedGame.history:type_name -> cards.proto.GameHistory
+	2,  // 38: cards.proto.GameHistory.phase:type_name -> cards.proto.GameState.Phase
+	66, // 39: cards.proto.GameHistory.players:type_name -> cards.proto.GameHistory.Player
+	67, // 40: cards.proto.GameHistory.events:type_name -> cards.proto.GameHistory.Event
+	71, // 41: cards.proto.GameAnalysis.decisions:type_name -> cards.proto.GameAnalysis.Decision
+	72, // 42: cards.proto.GameAnalysis.players:type_name -> cards.proto.GameAnalysis.PlayerSummary
+	73, // 43: cards.proto.Ratings.ratings:type_name -> cards.proto.Ratings.Rating
+	2,  // 44: cards.proto.ListGamesResponse.GameSummary.phase:type_name -> cards.proto.GameState.Phase
+	2,  // 45: cards.proto.ListPastGamesResponse.PastGame.phase:type_name -> cards.proto.GameState.Phase
+	49, // 46: cards.proto.GameState.Player.cards:type_name -> cards.proto.GameState.Cards
+	49, // 47: cards.proto.GameState.Player.tricks:type_name -> cards.proto.GameState.Cards
+	3,  // 48: cards.proto.GameState.Player.seat:type_name -> cards.proto.GameState.Seat
+	35, // 49: cards.proto.SavedGame.Session.sent:type_name -> cards.proto.GameActivity
+	68, // 50: cards.proto.GameHistory.Event.deal:type_name -> cards.proto.GameHistory.Deal
+	54, // 51: cards.proto.GameHistory.Event.card_played:type_name -> cards.proto.GameActivity.CardPlayed
+	55, // 52: cards.proto.GameHistory.Event.trick_completed:type_name -> cards.proto.GameActivity.TrickCompleted
+	69, // 53: cards.proto.GameHistory.Event.game_finished:type_name -> cards.proto.GameHistory.GameFinished
+	70, // 54: cards.proto.GameHistory.Event.game_aborted:type_name -> cards.proto.GameHistory.GameAborted
+	49, // 55: cards.proto.GameHistory.Deal.hands:type_name -> cards.proto.GameState.Cards
+	4,  // 56: cards.proto.GameAnalysis.Decision.grade:type_name -> cards.proto.GameAnalysis.Decision.Grade
+	36, // 57: cards.proto.CardGameService.Ping:input_type -> cards.proto.PingRequest
+	5,  // 58: cards.proto.CardGameService.Register:input_type -> cards.proto.RegisterRequest
+	7,  // 59: cards.proto.CardGameService.CreateGame:input_type -> cards.proto.CreateGameRequest
+	10, // 60: cards.proto.CardGameService.ListGames:input_type -> cards.proto.ListGamesRequest
+	9,  // 61: cards.proto.CardGameService.JoinGame:input_type -> cards.proto.JoinGameRequest
+	12, // 62: cards.proto.CardGameService.ObserveGame:input_type -> cards.proto.ObserveGameRequest
+	24, // 63: cards.proto.CardGameService.GameAction:input_type -> cards.proto.GameActionRequest
+	32, // 64: cards.proto.CardGameService.GetGameState:input_type -> cards.proto.GameStateRequest
+	13, // 65: cards.proto.CardGameService.ResumeSession:input_type -> cards.proto.ResumeSessionRequest
+	14, // 66: cards.proto.CardGameService.RejoinGame:input_type -> cards.proto.RejoinGameRequest
+	15, // 67: cards.proto.CardGameService.GetGameHistory:input_type -> cards.proto.GameHistoryRequest
+	16, // 68: cards.proto.CardGameService.ListPastGames:input_type -> cards.proto.ListPastGamesRequest
+	20, // 69: cards.proto.CardGameService.AnalyzeGame:input_type -> cards.proto.AnalyzeGameRequest
+	21, // 70: cards.proto.CardGameService.GetPlayerStats:input_type -> cards.proto.PlayerStatsRequest
+	22, // 71: cards.proto.CardGameService.GetLeaderboard:input_type -> cards.proto.LeaderboardRequest
+	18, // 72: cards.proto.CardGameService.JoinQueue:input_type -> cards.proto.JoinQueueRequest
+	37, // 73: cards.proto.CardGameService.Ping:output_type -> cards.proto.PingResponse
+	38, // 74: cards.proto.CardGameService.Register:output_type -> cards.proto.RegistryActivity
+	8,  // 75: cards.proto.CardGameService.CreateGame:output_type -> cards.proto.CreateGameResponse
+	11, // 76: cards.proto.CardGameService.ListGames:output_type -> cards.proto.ListGamesResponse
+	35, // 77: cards.proto.CardGameService.JoinGame:output_type -> cards.proto.GameActivity
+	35, // 78: cards.proto.CardGameService.ObserveGame:output_type -> cards.proto.GameActivity
+	34, // 79: cards.proto.CardGameService.GameAction:output_type -> cards.proto.Status
+	33, // 80: cards.proto.CardGameService.GetGameState:output_type -> cards.proto.GameState
+	38, // 81: cards.proto.CardGameService.ResumeSession:output_type -> cards.proto.RegistryActivity
+	35, // 82: cards.proto.CardGameService.RejoinGame:output_type -> cards.proto.GameActivity
+	40, // 83: cards.proto.CardGameService.GetGameHistory:output_type -> cards.proto.GameHistory
+	17, // 84: cards.proto.CardGameService.ListPastGames:output_type -> cards.proto.ListPastGamesResponse
+	41, // 85: cards.proto.CardGameService.AnalyzeGame:output_type -> cards.proto.GameAnalysis
+	42, // 86: cards.proto.CardGameService.GetPlayerStats:output_type -> cards.proto.PlayerStats
+	23, // 87: cards.proto.CardGameService.GetLeaderboard:output_type -> cards.proto.Leaderboard
+	19, // 88: cards.proto.CardGameService.JoinQueue:output_type -> cards.proto.QueueActivity
+	73, // [73:89] is the sub-list for method output_type
+	57, // [57:73] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_game_proto_init() }
@@ -2473,8 +5445,176 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejoinGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPastGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPastGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2485,8 +5625,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameRequest); i {
+		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2497,8 +5637,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGameResponse); i {
+		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2509,8 +5649,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinGameRequest); i {
+		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leaderboard); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2521,8 +5661,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGamesRequest); i {
+		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2533,8 +5673,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGamesResponse); i {
+		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyToStartGameAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2545,8 +5685,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObserveGameRequest); i {
+		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveGameAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2557,8 +5697,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameActionRequest); i {
+		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayCardAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2569,8 +5709,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyToStartGameAction); i {
+		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBotAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2581,8 +5721,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveGameAction); i {
+		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2593,8 +5733,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayCardAction); i {
+		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGameAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2605,7 +5745,19 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChooseSeatAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameStateRequest); i {
 			case 0:
 				return &v.state
@@ -2617,7 +5769,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameState); i {
 			case 0:
 				return &v.state
@@ -2629,7 +5781,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -2641,7 +5793,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity); i {
 			case 0:
 				return &v.state
@@ -2653,7 +5805,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
@@ -2665,7 +5817,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
@@ -2677,7 +5829,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistryActivity); i {
 			case 0:
 				return &v.state
@@ -2689,7 +5841,67 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ratings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListGamesResponse_GameSummary); i {
 			case 0:
 				return &v.state
@@ -2701,7 +5913,43 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPastGamesResponse_PastGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueActivity_Waiting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueActivity_GameAssigned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameState_Player); i {
 			case 0:
 				return &v.state
@@ -2713,7 +5961,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameState_Cards); i {
 			case 0:
 				return &v.state
@@ -2725,7 +5973,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_PlayerJoined); i {
 			case 0:
 				return &v.state
@@ -2737,7 +5985,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_PlayerLeft); i {
 			case 0:
 				return &v.state
@@ -2749,7 +5997,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_GameReadyToStart); i {
 			case 0:
 				return &v.state
@@ -2761,7 +6009,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_GameStarted); i {
 			case 0:
 				return &v.state
@@ -2773,7 +6021,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_CardPlayed); i {
 			case 0:
 				return &v.state
@@ -2785,7 +6033,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_TrickCompleted); i {
 			case 0:
 				return &v.state
@@ -2797,7 +6045,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_YourTurn); i {
 			case 0:
 				return &v.state
@@ -2809,7 +6057,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_GameFinished); i {
 			case 0:
 				return &v.state
@@ -2821,7 +6069,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameActivity_GameAborted); i {
 			case 0:
 				return &v.state
@@ -2833,7 +6081,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistryActivity_SessionCreated); i {
 			case 0:
 				return &v.state
@@ -2845,7 +6093,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistryActivity_GameCreated); i {
 			case 0:
 				return &v.state
@@ -2857,7 +6105,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistryActivity_GameDeleted); i {
 			case 0:
 				return &v.state
@@ -2869,7 +6117,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistryActivity_FullGamesList); i {
 			case 0:
 				return &v.state
@@ -2881,13 +6129,129 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
+		file_game_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedGame_Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory_Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory_Deal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory_GameFinished); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory_GameAborted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameAnalysis_Decision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameAnalysis_PlayerSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ratings_Rating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_game_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_game_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*QueueActivity_Waiting_)(nil),
+		(*QueueActivity_GameAssigned_)(nil),
+	}
+	file_game_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*GameActionRequest_ReadyToStartGame)(nil),
 		(*GameActionRequest_LeaveGame)(nil),
 		(*GameActionRequest_PlayCard)(nil),
+		(*GameActionRequest_AddBot)(nil),
+		(*GameActionRequest_KickPlayer)(nil),
+		(*GameActionRequest_StartGame)(nil),
+		(*GameActionRequest_ChooseSeat)(nil),
 	}
-	file_game_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_game_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*GameActivity_PlayerJoined_)(nil),
 		(*GameActivity_PlayerLeft_)(nil),
 		(*GameActivity_GameReadyToStart_)(nil),
@@ -2899,19 +6263,26 @@ func file_game_proto_init() {
 		(*GameActivity_GameAborted_)(nil),
 		(*GameActivity_BroadcastMsg)(nil),
 	}
-	file_game_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_game_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*RegistryActivity_SessionCreated_)(nil),
 		(*RegistryActivity_GameCreated_)(nil),
 		(*RegistryActivity_GameDeleted_)(nil),
 		(*RegistryActivity_FullGamesList_)(nil),
 	}
+	file_game_proto_msgTypes[62].OneofWrappers = []interface{}{
+		(*GameHistory_Event_Deal)(nil),
+		(*GameHistory_Event_CardPlayed)(nil),
+		(*GameHistory_Event_TrickCompleted)(nil),
+		(*GameHistory_Event_GameFinished)(nil),
+		(*GameHistory_Event_GameAborted)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_game_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   35,
+			NumEnums:      5,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,24 @@ type CardGameServiceClient interface {
 	ObserveGame(ctx context.Context, in *ObserveGameRequest, opts ...grpc.CallOption) (CardGameService_ObserveGameClient, error)
 	GameAction(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*Status, error)
 	GetGameState(ctx context.Context, in *GameStateRequest, opts ...grpc.CallOption) (*GameState, error)
+	// Reattaches to a session whose Register stream dropped, within the server's grace period.
+	ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (CardGameService_ResumeSessionClient, error)
+	// Reattaches to the seat of a game whose JoinGame stream dropped, within the grace period.
+	// Activity missed while disconnected is sent first.
+	RejoinGame(ctx context.Context, in *RejoinGameRequest, opts ...grpc.CallOption) (CardGameService_RejoinGameClient, error)
+	// Returns the record of a game that's over, kept after the game is deleted.
+	GetGameHistory(ctx context.Context, in *GameHistoryRequest, opts ...grpc.CallOption) (*GameHistory, error)
+	// Lists the games that are over, most recently started first.
+	ListPastGames(ctx context.Context, in *ListPastGamesRequest, opts ...grpc.CallOption) (*ListPastGamesResponse, error)
+	// Reviews each decision in a completed game against the engine's evaluation.
+	AnalyzeGame(ctx context.Context, in *AnalyzeGameRequest, opts ...grpc.CallOption) (*GameAnalysis, error)
+	// Returns a player's statistics over every completed game in the history.
+	GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error)
+	// Ranks the players in the history.
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	// Waits for a table of players who want the same game, creates the game for them,
+	// and sends its id. Ending the stream first leaves the queue.
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (CardGameService_JoinQueueClient, error)
 }
 
 type cardGameServiceClient struct {
@@ -181,6 +199,147 @@ func (c *cardGameServiceClient) GetGameState(ctx context.Context, in *GameStateR
 	return out, nil
 }
 
+func (c *cardGameServiceClient) ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (CardGameService_ResumeSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CardGameService_ServiceDesc.Streams[3], "/cards.proto.CardGameService/ResumeSession", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cardGameServiceResumeSessionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CardGameService_ResumeSessionClient interface {
+	Recv() (*RegistryActivity, error)
+	grpc.ClientStream
+}
+
+type cardGameServiceResumeSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *cardGameServiceResumeSessionClient) Recv() (*RegistryActivity, error) {
+	m := new(RegistryActivity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cardGameServiceClient) RejoinGame(ctx context.Context, in *RejoinGameRequest, opts ...grpc.CallOption) (CardGameService_RejoinGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CardGameService_ServiceDesc.Streams[4], "/cards.proto.CardGameService/RejoinGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cardGameServiceRejoinGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CardGameService_RejoinGameClient interface {
+	Recv() (*GameActivity, error)
+	grpc.ClientStream
+}
+
+type cardGameServiceRejoinGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *cardGameServiceRejoinGameClient) Recv() (*GameActivity, error) {
+	m := new(GameActivity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cardGameServiceClient) GetGameHistory(ctx context.Context, in *GameHistoryRequest, opts ...grpc.CallOption) (*GameHistory, error) {
+	out := new(GameHistory)
+	err := c.cc.Invoke(ctx, "/cards.proto.CardGameService/GetGameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardGameServiceClient) ListPastGames(ctx context.Context, in *ListPastGamesRequest, opts ...grpc.CallOption) (*ListPastGamesResponse, error) {
+	out := new(ListPastGamesResponse)
+	err := c.cc.Invoke(ctx, "/cards.proto.CardGameService/ListPastGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardGameServiceClient) AnalyzeGame(ctx context.Context, in *AnalyzeGameRequest, opts ...grpc.CallOption) (*GameAnalysis, error) {
+	out := new(GameAnalysis)
+	err := c.cc.Invoke(ctx, "/cards.proto.CardGameService/AnalyzeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardGameServiceClient) GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error) {
+	out := new(PlayerStats)
+	err := c.cc.Invoke(ctx, "/cards.proto.CardGameService/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardGameServiceClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, "/cards.proto.CardGameService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardGameServiceClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (CardGameService_JoinQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CardGameService_ServiceDesc.Streams[5], "/cards.proto.CardGameService/JoinQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cardGameServiceJoinQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CardGameService_JoinQueueClient interface {
+	Recv() (*QueueActivity, error)
+	grpc.ClientStream
+}
+
+type cardGameServiceJoinQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *cardGameServiceJoinQueueClient) Recv() (*QueueActivity, error) {
+	m := new(QueueActivity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CardGameServiceServer is the server API for CardGameService service.
 // All implementations must embed UnimplementedCardGameServiceServer
 // for forward compatibility
@@ -193,6 +352,24 @@ type CardGameServiceServer interface {
 	ObserveGame(*ObserveGameRequest, CardGameService_ObserveGameServer) error
 	GameAction(context.Context, *GameActionRequest) (*Status, error)
 	GetGameState(context.Context, *GameStateRequest) (*GameState, error)
+	// Reattaches to a session whose Register stream dropped, within the server's grace period.
+	ResumeSession(*ResumeSessionRequest, CardGameService_ResumeSessionServer) error
+	// Reattaches to the seat of a game whose JoinGame stream dropped, within the grace period.
+	// Activity missed while disconnected is sent first.
+	RejoinGame(*RejoinGameRequest, CardGameService_RejoinGameServer) error
+	// Returns the record of a game that's over, kept after the game is deleted.
+	GetGameHistory(context.Context, *GameHistoryRequest) (*GameHistory, error)
+	// Lists the games that are over, most recently started first.
+	ListPastGames(context.Context, *ListPastGamesRequest) (*ListPastGamesResponse, error)
+	// Reviews each decision in a completed game against the engine's evaluation.
+	AnalyzeGame(context.Context, *AnalyzeGameRequest) (*GameAnalysis, error)
+	// Returns a player's statistics over every completed game in the history.
+	GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStats, error)
+	// Ranks the players in the history.
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error)
+	// Waits for a table of players who want the same game, creates the game for them,
+	// and sends its id. Ending the stream first leaves the queue.
+	JoinQueue(*JoinQueueRequest, CardGameService_JoinQueueServer) error
 	mustEmbedUnimplementedCardGameServiceServer()
 }
 
@@ -224,6 +401,30 @@ func (UnimplementedCardGameServiceServer) GameAction(context.Context, *GameActio
 func (UnimplementedCardGameServiceServer) GetGameState(context.Context, *GameStateRequest) (*GameState, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
 }
+func (UnimplementedCardGameServiceServer) ResumeSession(*ResumeSessionRequest, CardGameService_ResumeSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeSession not implemented")
+}
+func (UnimplementedCardGameServiceServer) RejoinGame(*RejoinGameRequest, CardGameService_RejoinGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method RejoinGame not implemented")
+}
+func (UnimplementedCardGameServiceServer) GetGameHistory(context.Context, *GameHistoryRequest) (*GameHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameHistory not implemented")
+}
+func (UnimplementedCardGameServiceServer) ListPastGames(context.Context, *ListPastGamesRequest) (*ListPastGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPastGames not implemented")
+}
+func (UnimplementedCardGameServiceServer) AnalyzeGame(context.Context, *AnalyzeGameRequest) (*GameAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeGame not implemented")
+}
+func (UnimplementedCardGameServiceServer) GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedCardGameServiceServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedCardGameServiceServer) JoinQueue(*JoinQueueRequest, CardGameService_JoinQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
 func (UnimplementedCardGameServiceServer) mustEmbedUnimplementedCardGameServiceServer() {}
 
 // UnsafeCardGameServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -390,6 +591,159 @@ func _CardGameService_GetGameState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardGameService_ResumeSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeSessionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CardGameServiceServer).ResumeSession(m, &cardGameServiceResumeSessionServer{stream})
+}
+
+type CardGameService_ResumeSessionServer interface {
+	Send(*RegistryActivity) error
+	grpc.ServerStream
+}
+
+type cardGameServiceResumeSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *cardGameServiceResumeSessionServer) Send(m *RegistryActivity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CardGameService_RejoinGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RejoinGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CardGameServiceServer).RejoinGame(m, &cardGameServiceRejoinGameServer{stream})
+}
+
+type CardGameService_RejoinGameServer interface {
+	Send(*GameActivity) error
+	grpc.ServerStream
+}
+
+type cardGameServiceRejoinGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *cardGameServiceRejoinGameServer) Send(m *GameActivity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CardGameService_GetGameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardGameServiceServer).GetGameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cards.proto.CardGameService/GetGameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardGameServiceServer).GetGameHistory(ctx, req.(*GameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardGameService_ListPastGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPastGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardGameServiceServer).ListPastGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cards.proto.CardGameService/ListPastGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardGameServiceServer).ListPastGames(ctx, req.(*ListPastGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardGameService_AnalyzeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardGameServiceServer).AnalyzeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cards.proto.CardGameService/AnalyzeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardGameServiceServer).AnalyzeGame(ctx, req.(*AnalyzeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardGameService_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardGameServiceServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cards.proto.CardGameService/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardGameServiceServer).GetPlayerStats(ctx, req.(*PlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardGameService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardGameServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cards.proto.CardGameService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardGameServiceServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardGameService_JoinQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CardGameServiceServer).JoinQueue(m, &cardGameServiceJoinQueueServer{stream})
+}
+
+type CardGameService_JoinQueueServer interface {
+	Send(*QueueActivity) error
+	grpc.ServerStream
+}
+
+type cardGameServiceJoinQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *cardGameServiceJoinQueueServer) Send(m *QueueActivity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // CardGameService_ServiceDesc is the grpc.ServiceDesc for CardGameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +771,26 @@ var CardGameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGameState",
 			Handler:    _CardGameService_GetGameState_Handler,
 		},
+		{
+			MethodName: "GetGameHistory",
+			Handler:    _CardGameService_GetGameHistory_Handler,
+		},
+		{
+			MethodName: "ListPastGames",
+			Handler:    _CardGameService_ListPastGames_Handler,
+		},
+		{
+			MethodName: "AnalyzeGame",
+			Handler:    _CardGameService_AnalyzeGame_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _CardGameService_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _CardGameService_GetLeaderboard_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -434,6 +808,21 @@ var CardGameService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CardGameService_ObserveGame_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResumeSession",
+			Handler:       _CardGameService_ResumeSession_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RejoinGame",
+			Handler:       _CardGameService_RejoinGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JoinQueue",
+			Handler:       _CardGameService_JoinQueue_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "game.proto",
 }
@@ -0,0 +1,351 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/game"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// HistoryStore keeps the records of games that are over.
+type HistoryStore interface {
+	// Adds a game's history, replacing any earlier history with its game id.
+	AddGame(h *pb.GameHistory) error
+	// Returns a game's history, or an error if there's none.
+	GetGame(gameId string) (*pb.GameHistory, error)
+	// Returns every game's history.
+	ListGames() ([]*pb.GameHistory, error)
+}
+
+// Returns a HistoryStore that keeps the history in memory, so it's lost when the server stops.
+func NewMemoryHistory() HistoryStore {
+	return &memoryHistory{games: make(map[string]*pb.GameHistory)}
+}
+
+type memoryHistory struct {
+	mu    sync.Mutex
+	games map[string]*pb.GameHistory // Keyed by gameId
+}
+
+func (mh *memoryHistory) AddGame(h *pb.GameHistory) error {
+	mh.mu.Lock()
+	defer mh.mu.Unlock()
+	mh.games[h.GetGameId()] = proto.Clone(h).(*pb.GameHistory)
+	return nil
+}
+
+func (mh *memoryHistory) GetGame(gameId string) (*pb.GameHistory, error) {
+	mh.mu.Lock()
+	defer mh.mu.Unlock()
+	h, ok := mh.games[gameId]
+	if !ok {
+		return nil, fmt.Errorf("no history of game %s", gameId)
+	}
+	return h, nil
+}
+
+func (mh *memoryHistory) ListGames() ([]*pb.GameHistory, error) {
+	mh.mu.Lock()
+	defer mh.mu.Unlock()
+	var hs []*pb.GameHistory
+	for _, h := range mh.games {
+		hs = append(hs, h)
+	}
+	return hs, nil
+}
+
+const historySuffix = ".history"
+
+// Returns a HistoryStore that keeps each game's history in a file in dir, creating dir if needed.
+func NewFileHistory(dir string) (HistoryStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return fileHistory{dir: dir}, nil
+}
+
+type fileHistory struct {
+	dir string
+}
+
+func (fh fileHistory) path(gameId string) string {
+	return filepath.Join(fh.dir, gameId+historySuffix)
+}
+
+func (fh fileHistory) AddGame(h *pb.GameHistory) error {
+	if err := checkGameId(h.GetGameId()); err != nil {
+		return err
+	}
+	data, err := proto.Marshal(h)
+	if err != nil {
+		return err
+	}
+	return writeFile(fh.dir, fh.path(h.GetGameId()), data)
+}
+
+func (fh fileHistory) GetGame(gameId string) (*pb.GameHistory, error) {
+	if err := checkGameId(gameId); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(fh.path(gameId))
+	if err != nil {
+		return nil, fmt.Errorf("no history of game %s: %w", gameId, err)
+	}
+	h := &pb.GameHistory{}
+	if err := proto.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("can't read history of game %s: %w", gameId, err)
+	}
+	return h, nil
+}
+
+func (fh fileHistory) ListGames() ([]*pb.GameHistory, error) {
+	entries, err := os.ReadDir(fh.dir)
+	if err != nil {
+		return nil, err
+	}
+	var hs []*pb.GameHistory
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), historySuffix) {
+			continue
+		}
+		h, err := fh.GetGame(strings.TrimSuffix(e.Name(), historySuffix))
+		if err != nil {
+			// One bad file shouldn't hide the rest of the history.
+			log.Printf("Skipping %s: %v", e.Name(), err)
+			continue
+		}
+		hs = append(hs, h)
+	}
+	return hs, nil
+}
+
+// Starts recording a game that has just started, beginning with its deal.
+func (s *cardGameService) startHistory(gs *gameSession) {
+	g := gs.game
+	state, err := g.GetGameState("")
+	if err != nil {
+		log.Printf("Can't record history of game %s: %v", g.Id(), err)
+		return
+	}
+	gs.history = &pb.GameHistory{GameId: g.Id(), Phase: pb.GameState_Playing}
+	deal := &pb.GameHistory_Deal{}
+	for _, p := range state.GetPlayers() {
+		gs.history.Players = append(gs.history.Players, &pb.GameHistory_Player{
+			Id:    p.GetId(),
+			Name:  p.GetName(),
+			IsBot: p.GetIsBot(),
+		})
+		deal.Hands = append(deal.Hands, p.GetCards())
+	}
+	gs.recordEvent(&pb.GameHistory_Event{Type: &pb.GameHistory_Event_Deal{Deal: deal}})
+}
+
+// Adds event to the game's history, if it's being recorded.
+func (gs *gameSession) recordEvent(event *pb.GameHistory_Event) {
+	if gs.history == nil {
+		return
+	}
+	event.Time = time.Now().UnixMilli()
+	gs.history.Events = append(gs.history.Events, event)
+}
+
+// Records the end of a game and keeps its history.
+func (s *cardGameService) finishHistory(gs *gameSession) {
+	h := gs.history
+	if h == nil || h.GetPhase() != pb.GameState_Playing {
+		// Never started, or already over.
+		return
+	}
+	g := gs.game
+	switch g.Phase() {
+	case game.Completed:
+		state, err := g.GetGameState("")
+		if err != nil {
+			log.Printf("Can't record end of game %s: %v", g.Id(), err)
+			return
+		}
+		finished := &pb.GameHistory_GameFinished{}
+		for _, p := range state.GetPlayers() {
+			finished.HandScores = append(finished.HandScores, p.GetHandScore())
+		}
+		gs.recordEvent(&pb.GameHistory_Event{Type: &pb.GameHistory_Event_GameFinished{GameFinished: finished}})
+	case game.Aborted:
+		gs.recordEvent(&pb.GameHistory_Event{Type: &pb.GameHistory_Event_GameAborted{GameAborted: &pb.GameHistory_GameAborted{}}})
+	default:
+		return
+	}
+	h.Phase = g.Phase().ToProto()
+	if err := s.history.AddGame(h); err != nil {
+		log.Printf("Couldn't keep history of game %s: %v", g.Id(), err)
+	}
+}
+
+func (s *cardGameService) GetGameHistory(ctx context.Context, req *pb.GameHistoryRequest) (*pb.GameHistory, error) {
+	if err := checkGameId(req.GetGameId()); err != nil {
+		return nil, err
+	}
+	// Games being played aren't in the history, so their hands stay hidden.
+	return s.history.GetGame(req.GetGameId())
+}
+
+func (s *cardGameService) ListPastGames(ctx context.Context, req *pb.ListPastGamesRequest) (*pb.ListPastGamesResponse, error) {
+	hs, err := s.history.ListGames()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListPastGamesResponse{}
+	for _, h := range hs {
+		pg := summarizeHistory(h)
+		if name := req.GetPlayerName(); name != "" && !playedIn(h, name) {
+			continue
+		}
+		if after := req.GetStartedAfter(); after != 0 && pg.GetStartTime() < after {
+			continue
+		}
+		if before := req.GetStartedBefore(); before != 0 && pg.GetStartTime() >= before {
+			continue
+		}
+		resp.Games = append(resp.Games, pg)
+	}
+	sort.Slice(resp.Games, func(i, j int) bool {
+		return resp.Games[i].GetStartTime() > resp.Games[j].GetStartTime()
+	})
+	return resp, nil
+}
+
+// Analyses kept once they're finished, and analyses run at once.
+const (
+	maxAnalyses        = 100
+	maxRunningAnalyses = 2
+)
+
+// An analysis of a past game, shared by everyone who asks for it while it runs.
+type analysis struct {
+	done    chan struct{}      // Closed when it's finished.
+	cancel  context.CancelFunc // Stops it, once no one is waiting for it.
+	waiting int                // Requests waiting for it to finish.
+	result  *pb.GameAnalysis
+	err     error
+}
+
+func (s *cardGameService) AnalyzeGame(ctx context.Context, req *pb.AnalyzeGameRequest) (*pb.GameAnalysis, error) {
+	if s.opts.Analyzer == nil {
+		return nil, fmt.Errorf("this server doesn't analyze games")
+	}
+	gameId := req.GetGameId()
+	if err := checkGameId(gameId); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	a, ok := s.analyses[gameId]
+	if !ok {
+		actx, cancel := context.WithCancel(context.Background())
+		a = &analysis{done: make(chan struct{}), cancel: cancel}
+		s.analyses[gameId] = a
+		go s.runAnalysis(actx, gameId, a)
+	}
+	a.waiting++
+	s.mu.Unlock()
+
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a.waiting--
+	select {
+	case <-a.done:
+		return a.result, a.err
+	default:
+	}
+	if a.waiting == 0 {
+		// No one else wants it, so stop it, and start afresh if it's asked for again.
+		a.cancel()
+		if s.analyses[gameId] == a {
+			delete(s.analyses, gameId)
+		}
+	}
+	return nil, ctx.Err()
+}
+
+// Analyzes the game once there's a free slot, and keeps the analysis if it succeeds.
+func (s *cardGameService) runAnalysis(ctx context.Context, gameId string, a *analysis) {
+	result, err := s.analyzeGame(ctx, gameId)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a.result, a.err = result, err
+	close(a.done)
+	a.cancel()
+	if s.analyses[gameId] != a {
+		return
+	}
+	if err != nil {
+		delete(s.analyses, gameId)
+		return
+	}
+	s.analysisOrder = append(s.analysisOrder, gameId)
+	for len(s.analysisOrder) > maxAnalyses {
+		delete(s.analyses, s.analysisOrder[0])
+		s.analysisOrder = s.analysisOrder[1:]
+	}
+}
+
+func (s *cardGameService) analyzeGame(ctx context.Context, gameId string) (*pb.GameAnalysis, error) {
+	select {
+	case s.analysisSlots <- struct{}{}:
+		defer func() { <-s.analysisSlots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	h, err := s.history.GetGame(gameId)
+	if err != nil {
+		return nil, err
+	}
+	if h.GetPhase() != pb.GameState_Completed {
+		return nil, fmt.Errorf("game %s wasn't completed", gameId)
+	}
+	return s.opts.Analyzer.AnalyzeGame(ctx, h)
+}
+
+func summarizeHistory(h *pb.GameHistory) *pb.ListPastGamesResponse_PastGame {
+	pg := &pb.ListPastGamesResponse_PastGame{
+		Id:    h.GetGameId(),
+		Phase: h.GetPhase(),
+	}
+	for _, p := range h.GetPlayers() {
+		pg.PlayerNames = append(pg.PlayerNames, p.GetName())
+	}
+	events := h.GetEvents()
+	if len(events) > 0 {
+		pg.StartTime = events[0].GetTime()
+		last := events[len(events)-1]
+		pg.EndTime = last.GetTime()
+		pg.HandScores = last.GetGameFinished().GetHandScores()
+	}
+	return pg
+}
+
+// Returns whether someone called name played in the game, including taking over a seat.
+func playedIn(h *pb.GameHistory, name string) bool {
+	for _, p := range h.GetPlayers() {
+		if p.GetName() == name {
+			return true
+		}
+	}
+	for _, e := range h.GetEvents() {
+		if e.GetCardPlayed().GetPlayerName() == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/mpsalisbury/cards/pkg/game"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// Returns the ids of the games that ListGames shows.
+func (s *cardGameService) publicGameIds() []string {
+	var gameIds []string
+	for gameId, gs := range s.games {
+		if !gs.private {
+			gameIds = append(gameIds, gameId)
+		}
+	}
+	return gameIds
+}
+
+// Returns the number of seats left for players the seats aren't kept for.
+func (gs *gameSession) openSeats() int {
+	open := tableSize - len(gs.game.PlayerNames()) - len(gs.reserved) - len(gs.reservedNames)
+	if open < 0 {
+		return 0
+	}
+	return open
+}
+
+// Returns why player can't join the game with joinCode, if they can't.
+func (gs *gameSession) checkEntry(player *playerSession, joinCode string) error {
+	g := gs.game
+	if g.Phase() != game.Preparing {
+		// Only people who left, and bots replacing them, can take a seat once it's started.
+		return nil
+	}
+	if _, ok := gs.kicked[player.name]; ok && !player.isBot {
+		return fmt.Errorf("%s was removed from game %s by its host", player.name, g.Id())
+	}
+	if player.isBot && player.botGameId == g.Id() && gs.botInvites > 0 {
+		gs.botInvites--
+		return nil
+	}
+	if gs.joinCode == "" {
+		return nil
+	}
+	if joinCode == "" {
+		return fmt.Errorf("joining game %s needs its join code", g.Id())
+	}
+	if joinCode != gs.joinCode {
+		return fmt.Errorf("wrong join code for game %s", g.Id())
+	}
+	return nil
+}
+
+// Returns why sessionId can't see the game with joinCode, if it can't. The host, and
+// sessions playing in or watching the game, don't need the code.
+func (gs *gameSession) checkViewer(sessionId, joinCode string) error {
+	if gs.joinCode == "" || joinCode == gs.joinCode {
+		return nil
+	}
+	if sessionId != "" {
+		_, listening := gs.listeners[sessionId]
+		_, away := gs.disconnected[sessionId]
+		if listening || away || sessionId == gs.host || gs.game.HasPlayer(gs.seatOf(sessionId)) {
+			return nil
+		}
+	}
+	if joinCode == "" {
+		return fmt.Errorf("watching game %s needs its join code", gs.game.Id())
+	}
+	return fmt.Errorf("wrong join code for game %s", gs.game.Id())
+}
+
+// Returns gameId's game if sessionId is its host and it hasn't started.
+func (s *cardGameService) hostedGame(sessionId, gameId, action string) (*gameSession, error) {
+	gs, found := s.games[gameId]
+	if !found {
+		return nil, fmt.Errorf("no game %s found", gameId)
+	}
+	if gs.host == "" || sessionId != gs.host {
+		return nil, fmt.Errorf("only the host of game %s can %s", gameId, action)
+	}
+	if gs.game.Phase() != game.Preparing {
+		return nil, fmt.Errorf("can only %s before game %s starts", action, gameId)
+	}
+	return gs, nil
+}
+
+// Removes the player called name from a game that hasn't started, and stops them coming back.
+func (s *cardGameService) handleKickPlayer(sessionId, gameId, name string) error {
+	gs, err := s.hostedGame(sessionId, gameId, "kick players")
+	if err != nil {
+		return err
+	}
+	g := gs.game
+	for playerId := range gs.listeners {
+		player, ok := s.players[playerId]
+		if !ok || player.name != name || !g.HasPlayer(playerId) {
+			continue
+		}
+		if playerId == sessionId {
+			return fmt.Errorf("the host can't kick themself from game %s", gameId)
+		}
+		if err := g.RemovePlayer(playerId); err != nil {
+			return err
+		}
+		delete(player.gameIds, gameId)
+		if !player.isBot {
+			gs.kicked[name] = struct{}{}
+		}
+		s.ReportPlayerLeft(g, name)
+		// Ending the game for them ends their stream.
+		gs.send(playerId, &pb.GameActivity_BroadcastMsg{BroadcastMsg: fmt.Sprintf("You were removed from game %s by its host", gameId)})
+		gs.send(playerId, &pb.GameActivity_GameAborted_{})
+		return nil
+	}
+	return fmt.Errorf("no player %s in game %s", name, gameId)
+}
+
+// Fills the empty seats of a game that hasn't started with bots of playerType, including
+// any kept for players who haven't joined.
+func (s *cardGameService) handleStartGame(sessionId, gameId, playerType string) error {
+	gs, err := s.hostedGame(sessionId, gameId, "start it early")
+	if err != nil {
+		return err
+	}
+	if playerType == "" {
+		playerType = "basic"
+	}
+	if s.opts.Bots == nil {
+		return fmt.Errorf("this server doesn't host bots")
+	}
+	if err := s.opts.Bots.CheckPlayerType(playerType); err != nil {
+		return err
+	}
+	gs.reserved = make(map[string]struct{})
+	gs.reservedNames = make(map[string]struct{})
+	empty := gs.openSeats() - gs.botInvites
+	if empty <= 0 {
+		return fmt.Errorf("game %s has no empty seats; it starts when everyone is ready", gameId)
+	}
+	s.BroadcastMessage(gs.game, fmt.Sprintf("The host is starting game %s with %d %s bots", gameId, empty, playerType))
+	for i := 0; i < empty; i++ {
+		gs.botInvites++
+		go s.startInvitedBot(gameId, playerType)
+	}
+	return nil
+}
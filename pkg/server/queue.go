@@ -0,0 +1,259 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/game"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// Players at a table. Every game type so far is for four players.
+const tableSize = 4
+
+// How long queued players have to join the game they're given, unless Options says otherwise.
+const defaultQueueJoinWait = time.Minute
+
+// A table being formed from players waiting in the queue.
+type queuedTable struct {
+	key     string // Game type and rules.
+	entries []*queueEntry
+	timer   *time.Timer // Fills the table with bots, if there are bots to fill it.
+}
+
+// A session waiting in the queue.
+type queueEntry struct {
+	sessionId string
+	rating    float64
+	maxGap    float64 // 0 means any rating.
+	table     *queuedTable
+	gameId    string        // Set once the table's game is created.
+	wake      chan struct{} // Signalled when the table changes.
+}
+
+func (e *queueEntry) signal() {
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Returns the queue that players wanting gameType with rules wait in.
+func queueKey(gameType, rules string) (string, error) {
+	if gameType == "" {
+		gameType = "hearts"
+	}
+	if rules == "" {
+		rules = "standard"
+	}
+	if gameType != "hearts" {
+		return "", fmt.Errorf("unknown game type %q", gameType)
+	}
+	if rules != "standard" {
+		return "", fmt.Errorf("unknown rules %q for %s", rules, gameType)
+	}
+	return gameType + "/" + rules, nil
+}
+
+func (s *cardGameService) JoinQueue(req *pb.JoinQueueRequest, resp pb.CardGameService_JoinQueueServer) error {
+	key, err := queueKey(req.GetGameType(), req.GetRules())
+	if err != nil {
+		return err
+	}
+	sessionId := req.GetSessionId()
+	s.mu.Lock()
+	player, ok := s.players[sessionId]
+	if !ok {
+		s.mu.Unlock()
+		return fmt.Errorf("sessionId %s not found", sessionId)
+	}
+	for _, t := range s.queue {
+		for _, e := range t.entries {
+			if e.sessionId == sessionId {
+				s.mu.Unlock()
+				return fmt.Errorf("session %s is already waiting in the queue", sessionId)
+			}
+		}
+	}
+	e := &queueEntry{
+		sessionId: sessionId,
+		rating:    s.ratingOf(player.name),
+		maxGap:    req.GetMaxRatingGap(),
+		wake:      make(chan struct{}, 1),
+	}
+	s.addToQueue(key, e)
+	s.mu.Unlock()
+
+	for {
+		select {
+		case <-e.wake:
+		case <-resp.Context().Done():
+			s.mu.Lock()
+			s.leaveQueue(e)
+			s.mu.Unlock()
+			return resp.Context().Err()
+		}
+		s.mu.Lock()
+		activity := &pb.QueueActivity{}
+		if e.gameId != "" {
+			activity.Type = &pb.QueueActivity_GameAssigned_{GameAssigned: &pb.QueueActivity_GameAssigned{GameId: e.gameId}}
+		} else {
+			activity.Type = &pb.QueueActivity_Waiting_{Waiting: &pb.QueueActivity_Waiting{
+				Players: int32(len(e.table.entries)),
+				Seats:   tableSize,
+			}}
+		}
+		s.mu.Unlock()
+		if err := resp.Send(activity); err != nil {
+			s.mu.Lock()
+			s.leaveQueue(e)
+			s.mu.Unlock()
+			return err
+		}
+		if activity.GetGameAssigned() != nil {
+			return nil
+		}
+	}
+}
+
+// Seats e at a table waiting in queue key that it fits, or a new one.
+// Creates the table's game once it's full.
+func (s *cardGameService) addToQueue(key string, e *queueEntry) {
+	var table *queuedTable
+	for _, t := range s.queue {
+		if t.key == key && fitsTable(t, e) {
+			table = t
+			break
+		}
+	}
+	if table == nil {
+		table = &queuedTable{key: key}
+		s.queue = append(s.queue, table)
+		if s.opts.Bots != nil && s.opts.QueueBot != "" {
+			table.timer = time.AfterFunc(s.opts.QueueWait, func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				if len(table.entries) > 0 && table.entries[0].gameId == "" {
+					s.formTable(table)
+				}
+			})
+		}
+	}
+	e.table = table
+	table.entries = append(table.entries, e)
+	if len(table.entries) == tableSize {
+		s.formTable(table)
+		return
+	}
+	for _, other := range table.entries {
+		other.signal()
+	}
+}
+
+// Returns whether e can sit at t, with the others' ratings within what each of them asked for.
+func fitsTable(t *queuedTable, e *queueEntry) bool {
+	if len(t.entries) >= tableSize {
+		return false
+	}
+	for _, other := range t.entries {
+		gap := math.Abs(e.rating - other.rating)
+		if (e.maxGap > 0 && gap > e.maxGap) || (other.maxGap > 0 && gap > other.maxGap) {
+			return false
+		}
+	}
+	return true
+}
+
+// Takes e out of the queue. If its game was already created, frees its seat there instead.
+func (s *cardGameService) leaveQueue(e *queueEntry) {
+	if e.gameId != "" {
+		if gs, ok := s.games[e.gameId]; ok {
+			delete(gs.reserved, e.sessionId)
+		}
+		return
+	}
+	t := e.table
+	for i, other := range t.entries {
+		if other == e {
+			t.entries = append(t.entries[:i], t.entries[i+1:]...)
+			break
+		}
+	}
+	if len(t.entries) > 0 {
+		for _, other := range t.entries {
+			other.signal()
+		}
+		return
+	}
+	s.removeTable(t)
+}
+
+func (s *cardGameService) removeTable(t *queuedTable) {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	for i, other := range s.queue {
+		if other == t {
+			s.queue = append(s.queue[:i], s.queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// Creates the game for the players at t, keeping their seats for them, and fills the
+// rest of the seats with bots.
+func (s *cardGameService) formTable(t *queuedTable) {
+	s.removeTable(t)
+	gs, err := s.addGame(nil, false)
+	if err != nil {
+		log.Printf("Couldn't create game for queued table: %v", err)
+		return
+	}
+	gameId := gs.game.Id()
+	for _, e := range t.entries {
+		gs.reserved[e.sessionId] = struct{}{}
+		e.gameId = gameId
+		e.signal()
+	}
+	log.Printf("Created game %s for %d queued players", gameId, len(t.entries))
+	if s.opts.Bots != nil && s.opts.QueueBot != "" {
+		for i := len(t.entries); i < tableSize; i++ {
+			go s.startBot(gameId, s.opts.QueueBot)
+		}
+	}
+	wait := s.opts.QueueJoinWait
+	if wait == 0 {
+		wait = defaultQueueJoinWait
+	}
+	time.AfterFunc(wait, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.releaseQueueSeats(gs, t.entries)
+	})
+}
+
+// Frees the seats in gs still kept for queued players who haven't joined it, and fills
+// them with bots if there are bots to fill them.
+func (s *cardGameService) releaseQueueSeats(gs *gameSession, entries []*queueEntry) {
+	if gs.game.Phase() != game.Preparing {
+		return
+	}
+	released := 0
+	for _, e := range entries {
+		if _, ok := gs.reserved[e.sessionId]; ok {
+			delete(gs.reserved, e.sessionId)
+			released++
+		}
+	}
+	if released == 0 {
+		return
+	}
+	log.Printf("Freed %d seats in game %s kept for queued players who didn't join", released, gs.game.Id())
+	if s.opts.Bots != nil && s.opts.QueueBot != "" {
+		for i := 0; i < released; i++ {
+			go s.startBot(gs.game.Id(), s.opts.QueueBot)
+		}
+	}
+}
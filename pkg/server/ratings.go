@@ -0,0 +1,175 @@
+package server
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Ratings are Elo ratings, extended to a table of four by scoring each game as a match
+// between every pair of players, won by the one with the lower hand score.
+const (
+	initialRating = 1500.0
+	// Most a player's rating can change in one game, against the rest of the table.
+	ratingK = 32.0
+)
+
+// RatingStore keeps players' skill ratings so that they survive the server restarting.
+type RatingStore interface {
+	// Saves the ratings, replacing any saved earlier.
+	SaveRatings(r *pb.Ratings) error
+	// Returns the saved ratings, or none if nothing has been saved yet.
+	LoadRatings() (*pb.Ratings, error)
+}
+
+// Returns a RatingStore that keeps the ratings in a file at path, creating its directory if needed.
+func NewFileRatings(path string) (RatingStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return fileRatings{path: path}, nil
+}
+
+type fileRatings struct {
+	path string
+}
+
+func (fr fileRatings) SaveRatings(r *pb.Ratings) error {
+	data, err := proto.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Dir(fr.path), fr.path, data)
+}
+
+func (fr fileRatings) LoadRatings() (*pb.Ratings, error) {
+	r := &pb.Ratings{}
+	data, err := os.ReadFile(fr.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reads the saved ratings, if there's a RatingStore.
+func (s *cardGameService) loadRatings() {
+	if s.opts.Ratings == nil {
+		return
+	}
+	r, err := s.opts.Ratings.LoadRatings()
+	if err != nil {
+		log.Printf("Can't load ratings: %v", err)
+		return
+	}
+	for _, pr := range r.GetRatings() {
+		s.ratings[pr.GetName()] = pr
+	}
+}
+
+// Saves the ratings, if there's a RatingStore.
+func (s *cardGameService) saveRatings() {
+	if s.opts.Ratings == nil {
+		return
+	}
+	r := &pb.Ratings{}
+	for _, pr := range s.ratings {
+		r.Ratings = append(r.Ratings, pr)
+	}
+	sort.Slice(r.Ratings, func(i, j int) bool { return r.Ratings[i].GetName() < r.Ratings[j].GetName() })
+	if err := s.opts.Ratings.SaveRatings(r); err != nil {
+		log.Printf("Couldn't save ratings: %v", err)
+	}
+}
+
+// Returns the rating of the player called name.
+func (s *cardGameService) ratingOf(name string) float64 {
+	if pr, ok := s.ratings[name]; ok {
+		return pr.GetRating()
+	}
+	return initialRating
+}
+
+// Updates the ratings of the players who started a completed game from the hand scores
+// in its history. As in their statistics, a player who leaves is rated on the hand
+// finished for them by a replacement bot, so leaving doesn't protect a rating.
+func (s *cardGameService) updateRatings(gs *gameSession) {
+	h := gs.history
+	events := h.GetEvents()
+	if len(events) == 0 {
+		return
+	}
+	handScores := events[len(events)-1].GetGameFinished().GetHandScores()
+	players := h.GetPlayers()
+	if len(handScores) != len(players) {
+		log.Printf("Can't rate game %s with %d scores for %d players", h.GetGameId(), len(handScores), len(players))
+		return
+	}
+	var names []string
+	var scores []int32
+	for i, p := range players {
+		// Players without a name can't be told apart from one game to the next.
+		if p.GetName() != "" {
+			names = append(names, p.GetName())
+			scores = append(scores, handScores[i])
+		}
+	}
+	if len(names) < 2 {
+		return
+	}
+	var ratings []float64
+	for _, name := range names {
+		ratings = append(ratings, s.ratingOf(name))
+	}
+	changes := ratingChanges(ratings, scores)
+	// Bots of the same type share a name, and so a rating. What they win from each other cancels out.
+	counted := make(map[string]bool)
+	for i, name := range names {
+		pr, ok := s.ratings[name]
+		if !ok {
+			pr = &pb.Ratings_Rating{Name: name, Rating: initialRating}
+			s.ratings[name] = pr
+		}
+		pr.Rating += changes[i]
+		if !counted[name] {
+			pr.Games++
+			counted[name] = true
+		}
+	}
+	s.saveRatings()
+}
+
+// Returns how much each player's rating changes after a game, given their ratings and
+// hand scores going into it.
+func ratingChanges(ratings []float64, scores []int32) []float64 {
+	n := len(ratings)
+	changes := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, (ratings[j]-ratings[i])/400))
+			actual := 0.5
+			if scores[i] < scores[j] {
+				actual = 1
+			} else if scores[i] > scores[j] {
+				actual = 0
+			}
+			changes[i] += ratingK / float64(n-1) * (actual - expected)
+		}
+	}
+	return changes
+}
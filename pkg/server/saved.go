@@ -0,0 +1,179 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/game"
+	"github.com/mpsalisbury/cards/pkg/game/hearts"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Saves gs once the current request is done with it, so that everything it changed is saved together.
+func (s *cardGameService) scheduleSave(gs *gameSession) {
+	if s.opts.Store == nil || gs.saveScheduled {
+		return
+	}
+	gs.saveScheduled = true
+	go func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		gs.saveScheduled = false
+		s.saveGame(gs)
+	}()
+}
+
+// Saves a game being played, or deletes the save of one that's over.
+func (s *cardGameService) saveGame(gs *gameSession) {
+	gameId := gs.game.Id()
+	if s.games[gameId] != gs {
+		// Deleted since the save was scheduled.
+		return
+	}
+	if gs.game.Phase() != game.Playing {
+		s.unsaveGame(gameId)
+		return
+	}
+	data, err := s.marshalGame(gs)
+	if err == nil {
+		err = s.opts.Store.SaveGame(gameId, data)
+	}
+	if err != nil {
+		log.Printf("Couldn't save game %s: %v", gameId, err)
+	}
+}
+
+func (s *cardGameService) unsaveGame(gameId string) {
+	if s.opts.Store == nil {
+		return
+	}
+	if err := s.opts.Store.DeleteGame(gameId); err != nil {
+		log.Printf("Couldn't delete saved game %s: %v", gameId, err)
+	}
+}
+
+func (s *cardGameService) marshalGame(gs *gameSession) ([]byte, error) {
+	g := gs.game
+	state, err := g.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	saved := &pb.SavedGame{
+		GameId:       g.Id(),
+		State:        state,
+		Occupants:    make(map[string]string),
+		LeftBy:       make(map[string]string),
+		History:      gs.history,
+		Private:      gs.private,
+		JoinCode:     gs.joinCode,
+		Host:         gs.host,
+		Partnerships: gs.partnerships,
+	}
+	for seatId, occupant := range gs.occupants {
+		saved.Occupants[seatId] = occupant
+	}
+	for seatId, name := range gs.leftBy {
+		saved.LeftBy[seatId] = name
+	}
+	for sessionId, sent := range gs.sent {
+		seatId := gs.seatOf(sessionId)
+		if !g.HasPlayer(seatId) {
+			continue
+		}
+		p, ok := s.players[sessionId]
+		if !ok || p.isBot {
+			// Bots don't survive a restart, so new bots take their seats.
+			saved.Occupants[seatId] = ""
+			continue
+		}
+		session := &pb.SavedGame_Session{
+			Id:          p.id,
+			Name:        p.name,
+			ResumeToken: p.resumeToken,
+		}
+		for _, activity := range sent {
+			session.Sent = append(session.Sent, &pb.GameActivity{GameId: g.Id(), Type: activity})
+		}
+		saved.Sessions = append(saved.Sessions, session)
+	}
+	return proto.Marshal(saved)
+}
+
+// Restores the saved games. Called before the service is used.
+func (s *cardGameService) restoreGames() {
+	saved, err := s.opts.Store.LoadGames()
+	if err != nil {
+		log.Printf("Couldn't load saved games: %v", err)
+		return
+	}
+	for gameId, data := range saved {
+		if err := s.restoreGame(data); err != nil {
+			log.Printf("Couldn't restore game %s: %v", gameId, err)
+		}
+	}
+}
+
+// Restores a saved game with its players disconnected. It waits for them to rejoin
+// for as long as it would wait for them to play.
+func (s *cardGameService) restoreGame(data []byte) error {
+	saved := &pb.SavedGame{}
+	if err := proto.Unmarshal(data, saved); err != nil {
+		return err
+	}
+	g, err := hearts.RestoreGame(saved.GetState())
+	if err != nil {
+		return err
+	}
+	gameId := g.Id()
+	if gameId != saved.GetGameId() {
+		return fmt.Errorf("saved state is for game %s", gameId)
+	}
+	gs := newGameSession(g)
+	gs.history = saved.GetHistory()
+	gs.private = saved.GetPrivate()
+	gs.joinCode = saved.GetJoinCode()
+	gs.host = saved.GetHost()
+	gs.partnerships = saved.GetPartnerships()
+	for seatId, occupant := range saved.GetOccupants() {
+		gs.occupants[seatId] = occupant
+	}
+	for seatId, name := range saved.GetLeftBy() {
+		gs.leftBy[seatId] = name
+	}
+	for _, session := range saved.GetSessions() {
+		p, ok := s.players[session.GetId()]
+		if !ok {
+			p = &playerSession{
+				id:          session.GetId(),
+				name:        session.GetName(),
+				resumeToken: session.GetResumeToken(),
+				gameIds:     make(map[string]struct{}),
+				reportCh:    make(chan registryActivityReport, 4),
+			}
+			s.players[p.id] = p
+		}
+		p.gameIds[gameId] = struct{}{}
+		var sent []gameActivityReport
+		for _, activity := range session.GetSent() {
+			sent = append(sent, activity.GetType())
+		}
+		gs.sent[p.id] = sent
+		// No deadline, unlike a player whose connection drops.
+		gs.disconnected[p.id] = time.Time{}
+	}
+	s.games[gameId] = gs
+	log.Printf("Restored game %s with %d players to rejoin", gameId, len(saved.GetSessions()))
+	for _, occupant := range gs.occupants {
+		if occupant != "" {
+			continue
+		}
+		if s.opts.Bots == nil || s.opts.ReplacementBot == "" {
+			s.abortGame(g)
+			return fmt.Errorf("no bot to take an empty seat")
+		}
+		go s.startBot(gameId, s.opts.ReplacementBot)
+	}
+	return nil
+}
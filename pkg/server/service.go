@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
-	"math/rand"
+	mathrand "math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,13 +15,72 @@ import (
 	"github.com/mpsalisbury/cards/pkg/game"
 	"github.com/mpsalisbury/cards/pkg/game/hearts"
 	pb "github.com/mpsalisbury/cards/pkg/proto"
-	"golang.org/x/exp/maps"
 )
 
-func NewCardGameService() pb.CardGameServiceServer {
+// BotHost starts computer players for the server. The strategies are clients of the server,
+// so it can't start them itself.
+type BotHost interface {
+	// Returns an error if playerType isn't a kind of bot this host can start.
+	CheckPlayerType(playerType string) error
+	// Starts a bot of playerType that registers with service and joins gameId. Sessions
+	// registered with service are bots. Called without the service's lock held.
+	StartBot(service pb.CardGameServiceServer, gameId, playerType string) error
+}
+
+// GameAnalyzer reviews the play of games that are over. The engine that evaluates plays
+// is built on the server's clients, so the server can't analyze games itself.
+type GameAnalyzer interface {
+	// Returns a review of each decision in a completed game. It may take a while, and
+	// should stop early if ctx is done. Called without the service's lock held.
+	AnalyzeGame(ctx context.Context, h *pb.GameHistory) (*pb.GameAnalysis, error)
+}
+
+type Options struct {
+	Bots BotHost // Starts computer players. If nil, games have only people.
+	// Player type of the bot that takes over the seat of a player who leaves during a game.
+	// If empty, or there's no BotHost, the game is aborted instead.
+	ReplacementBot string
+	// How long a player whose connection drops has to resume their session and rejoin their
+	// games before they're treated as having left. If zero, they leave straight away.
+	ResumeGracePeriod time.Duration
+	// Saves games being played, which are restored when the server starts. If nil, games aren't saved.
+	// Restored games wait for their players to resume their sessions and rejoin.
+	Store GameStore
+	// Keeps the history of games that are over. If nil, it's kept in memory until the server stops.
+	History HistoryStore
+	// Analyzes completed games for AnalyzeGame. If nil, games can't be analyzed.
+	Analyzer GameAnalyzer
+	// Saves players' skill ratings, which are loaded when the server starts. If nil, they're
+	// kept in memory until the server stops.
+	Ratings RatingStore
+	// Player type of the bots that fill tables formed by JoinQueue that aren't full after QueueWait.
+	// If empty, or there's no BotHost, tables wait until they're full.
+	QueueBot  string
+	QueueWait time.Duration
+	// How long players given a game by JoinQueue have to join it before their seats are freed,
+	// and filled with QueueBot if there is one. If zero, they have defaultQueueJoinWait.
+	QueueJoinWait time.Duration
+}
+
+func NewCardGameService(opts Options) pb.CardGameServiceServer {
 	cgs := &cardGameService{
+		opts:    opts,
 		players: make(map[string]*playerSession),
 		games:   make(map[string]*gameSession),
+		history: opts.History,
+
+		analyses:      make(map[string]*analysis),
+		analysisSlots: make(chan struct{}, maxRunningAnalyses),
+		ratings:       make(map[string]*pb.Ratings_Rating),
+	}
+	if cgs.history == nil {
+		cgs.history = NewMemoryHistory()
+	}
+	cgs.loadRatings()
+	if opts.Store != nil {
+		cgs.mu.Lock()
+		cgs.restoreGames()
+		cgs.mu.Unlock()
 	}
 	cgs.startGarbageCollector()
 	return cgs
@@ -26,9 +88,19 @@ func NewCardGameService() pb.CardGameServiceServer {
 
 type cardGameService struct {
 	pb.UnsafeCardGameServiceServer
+	opts    Options
+	history HistoryStore              // Safe to use without mu.
 	mu      sync.Mutex                // Mutex for all data below
 	players map[string]*playerSession // Keyed by sessionId
 	games   map[string]*gameSession   // Keyed by gameId
+	// Analyses of past games, running or finished, keyed by gameId, since they take a while.
+	analyses      map[string]*analysis
+	analysisOrder []string      // Ids of the finished analyses, oldest first.
+	analysisSlots chan struct{} // Holds a token for each analysis running.
+	// Skill ratings, keyed by player name.
+	ratings map[string]*pb.Ratings_Rating
+	// Tables being formed by JoinQueue, oldest first.
+	queue []*queuedTable
 }
 
 type gameActivityReport = pb.GameActivity_Type
@@ -36,14 +108,117 @@ type registryActivityReport = pb.RegistryActivity_Type
 
 type gameSession struct {
 	game      game.Game
-	reportChs map[string]chan gameActivityReport // Keyed by sessionId
+	listeners map[string]*gameListener // Keyed by sessionId
+	// A seat keeps its player's id for the whole game. When a player leaves during play,
+	// another session takes over the seat, and these track who is playing it.
+	occupants map[string]string // Session now playing each taken-over seat, keyed by the seat's player id. "" while empty.
+	leftBy    map[string]string // Name of the player who left each taken-over seat, keyed by player id.
+	// Activity sent to each session, keyed by sessionId, so a player who rejoins can be sent what they missed.
+	sent map[string][]gameActivityReport
+	// When each seated player whose stream dropped during play lost it, keyed by sessionId,
+	// until they rejoin or the grace period runs out.
+	disconnected  map[string]time.Time
+	saveScheduled bool            // Whether a save of the game is waiting to run.
+	history       *pb.GameHistory // Record of the game, from when it starts.
+	presetPlays   cards.Cards     // Played as soon as the game starts, to continue a recorded game.
+	// Sessions whose seats are kept for them until they join, keyed by sessionId.
+	reserved map[string]struct{}
+	// Names of players whose seats are kept for them until they join.
+	reservedNames map[string]struct{}
+	private       bool   // Hidden from ListGames.
+	joinCode      string // Needed to join, if set.
+	host          string // Session of the host, if there is one.
+	partnerships  bool   // Players across from each other are partners.
+	// Names of players the host removed, who can't join again.
+	kicked map[string]struct{}
+	// Bots asked for by the host that haven't joined yet. They don't need the join code.
+	botInvites int
+}
+
+func newGameSession(g game.Game) *gameSession {
+	return &gameSession{
+		game:      g,
+		listeners: make(map[string]*gameListener),
+		occupants: make(map[string]string),
+		leftBy:    make(map[string]string),
+
+		sent:         make(map[string][]gameActivityReport),
+		disconnected: make(map[string]time.Time),
+		reserved:     make(map[string]struct{}),
+
+		reservedNames: make(map[string]struct{}),
+		kicked:        make(map[string]struct{}),
+	}
+}
+
+// A JoinGame, RejoinGame or ObserveGame stream, which sends its session's activity from sent.
+type gameListener struct {
+	wake chan struct{} // Signalled when there's more activity to send.
+	stop chan struct{} // Closed when the stream should end.
+}
+
+func (gs *gameSession) addListener(sessionId string) *gameListener {
+	l := &gameListener{wake: make(chan struct{}, 1), stop: make(chan struct{})}
+	gs.listeners[sessionId] = l
+	return l
+}
+
+// Ends sessionId's stream, if it has one.
+func (gs *gameSession) removeListener(sessionId string) {
+	if l, ok := gs.listeners[sessionId]; ok {
+		delete(gs.listeners, sessionId)
+		close(l.stop)
+	}
+}
+
+// Sends activity to sessionId's stream, or keeps it until they rejoin if they're disconnected.
+func (gs *gameSession) send(sessionId string, activity gameActivityReport) {
+	l, ok := gs.listeners[sessionId]
+	if _, away := gs.disconnected[sessionId]; !ok && !away {
+		return
+	}
+	gs.sent[sessionId] = append(gs.sent[sessionId], activity)
+	if ok {
+		select {
+		case l.wake <- struct{}{}:
+		default:
+			// Already woken.
+		}
+	}
+}
+
+// Returns the id of the player whose seat sessionId is playing.
+func (gs *gameSession) seatOf(sessionId string) string {
+	for seatId, occupant := range gs.occupants {
+		if occupant == sessionId {
+			return seatId
+		}
+	}
+	if _, ok := gs.occupants[sessionId]; ok {
+		// This seat has been taken over, so its original session no longer plays it.
+		return ""
+	}
+	return sessionId
+}
+
+// Returns the session playing playerId's seat.
+func (gs *gameSession) occupantOf(playerId string) string {
+	if occupant, ok := gs.occupants[playerId]; ok {
+		return occupant
+	}
+	return playerId
 }
 
 type playerSession struct {
-	id       string
-	name     string
-	gameIds  map[string]struct{} // All gameIds this player is participating in
-	reportCh chan registryActivityReport
+	id          string
+	name        string
+	isBot       bool
+	botGameId   string              // Game a bot was started for.
+	resumeToken string              // Secret that lets the player resume this session.
+	gameIds     map[string]struct{} // All gameIds this player is participating in
+	reportCh    chan registryActivityReport
+	listening   bool // Whether a Register or ResumeSession stream is reading reportCh.
+	attachments int  // Number of times a stream has attached to this session.
 }
 
 func (s *cardGameService) startGarbageCollector() {
@@ -70,25 +245,60 @@ func (*cardGameService) Ping(ctx context.Context, request *pb.PingRequest) (*pb.
 
 func (s *cardGameService) newSessionId() string {
 	for {
-		id := fmt.Sprintf("p%04d", rand.Int31n(10000))
-		// Ensure no collision with existing player id.
-		if _, found := s.players[id]; !found {
+		id := fmt.Sprintf("p%04d", mathrand.Int31n(10000))
+		// Ensure no collision with existing player id, or the seat of a player who has left.
+		if _, found := s.players[id]; !found && !s.isTakenOverSeat(id) {
 			return id
 		}
 	}
 }
-func (s *cardGameService) addPlayer(name string) *playerSession {
+func (s *cardGameService) isTakenOverSeat(playerId string) bool {
+	for _, gs := range s.games {
+		if _, ok := gs.occupants[playerId]; ok {
+			return true
+		}
+	}
+	return false
+}
+func (s *cardGameService) addPlayer(name string, isBot bool) *playerSession {
 	sessionId := s.newSessionId()
 	sess := &playerSession{
-		id:       sessionId,
-		name:     name,
-		gameIds:  make(map[string]struct{}),
-		reportCh: make(chan registryActivityReport, 4),
+		id:          sessionId,
+		name:        name,
+		isBot:       isBot,
+		resumeToken: newResumeToken(),
+		gameIds:     make(map[string]struct{}),
+		reportCh:    make(chan registryActivityReport, 4),
 	}
 	s.players[sessionId] = sess
 	log.Printf("Added player %s\n", sessionId)
 	return sess
 }
+func newResumeToken() string {
+	return randomHex(16)
+}
+
+// Returns n random bytes in hex.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("Can't make random id: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Returns the session with the given resume token, or nil if there's none.
+func (s *cardGameService) playerWithToken(token string) *playerSession {
+	if token == "" {
+		return nil
+	}
+	for _, p := range s.players {
+		if p.resumeToken == token {
+			return p
+		}
+	}
+	return nil
+}
 func (s *cardGameService) deletePlayer(playerId string) error {
 	p, ok := s.players[playerId]
 	if !ok {
@@ -103,25 +313,48 @@ func (s *cardGameService) deletePlayer(playerId string) error {
 	return nil
 }
 
+// Game ids are random enough not to repeat those of earlier games kept in the history,
+// or to be guessed.
 func (s *cardGameService) newGameId() string {
 	for {
-		id := fmt.Sprintf("g%04d", rand.Int31n(10000))
-		// Ensure no collision with existing game id.
+		id := "g" + randomHex(6)
 		if _, found := s.games[id]; !found {
 			return id
 		}
 	}
 }
-func (s *cardGameService) addGame() *gameSession {
+
+// Returns an error unless gameId has the form of the ids newGameId makes, g followed by hex
+// digits, so a game id from a client is safe to use in a file name.
+func checkGameId(gameId string) error {
+	digits := strings.TrimPrefix(gameId, "g")
+	if digits == gameId || digits == "" {
+		return fmt.Errorf("bad game id %q", gameId)
+	}
+	for _, c := range digits {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return fmt.Errorf("bad game id %q", gameId)
+		}
+	}
+	return nil
+}
+func (s *cardGameService) addGame(hands []cards.Cards, private bool) (*gameSession, error) {
 	gameId := s.newGameId()
 	g := hearts.NewGame(gameId)
-	gs := &gameSession{
-		game:      g,
-		reportChs: make(map[string]chan gameActivityReport),
+	if len(hands) > 0 {
+		var err error
+		g, err = hearts.NewGameWithDeal(gameId, hands)
+		if err != nil {
+			return nil, err
+		}
 	}
+	gs := newGameSession(g)
+	gs.private = private
 	s.games[gameId] = gs
-	s.reportGameCreated(gameId)
-	return gs
+	if !private {
+		s.reportGameCreated(gameId)
+	}
+	return gs, nil
 }
 
 func (s *cardGameService) removePlayerFromGame(playerId, gameId string) error {
@@ -132,26 +365,92 @@ func (s *cardGameService) removePlayerFromGame(playerId, gameId string) error {
 	}
 	if gs, found := s.games[gameId]; found {
 		g := gs.game
+		delete(gs.disconnected, playerId)
 		switch g.Phase() {
 		case game.Preparing:
+			if !g.HasPlayer(playerId) {
+				// Kicked by the host.
+				break
+			}
 			err := g.RemovePlayer(playerId)
 			if err != nil {
 				// Can't remove player, abort game
 				s.abortGame(g)
 			} else {
 				delete(player.gameIds, gameId)
-				delete(gs.reportChs, playerId)
+				gs.removeListener(playerId)
 				s.ReportPlayerLeft(g, player.name)
-				// How to stop listener here.
 			}
 		case game.Playing:
-			s.abortGame(g)
+			seatId := gs.seatOf(playerId)
+			if !g.HasPlayer(seatId) {
+				// Observers, and bots that have handed their seat back, don't hold a seat.
+				break
+			}
+			if !s.replaceWithBot(gs, seatId, player) {
+				s.abortGame(g)
+				break
+			}
+			// They can take their seat back by joining again.
+			delete(player.gameIds, gameId)
+			gs.removeListener(playerId)
 		case game.Completed, game.Aborted:
 			// Don't bother to remove player from completed or aborted game.
 		}
 	}
 	return nil
 }
+
+// Empties the seat of a player who left during play and starts a bot to take it over.
+// Returns false if there's no bot to take it.
+func (s *cardGameService) replaceWithBot(gs *gameSession, seatId string, player *playerSession) bool {
+	if s.opts.Bots == nil || s.opts.ReplacementBot == "" {
+		return false
+	}
+	g := gs.game
+	if _, ok := gs.leftBy[seatId]; !ok {
+		// When a replacement bot leaves, the seat is still kept for the person it replaced.
+		gs.leftBy[seatId] = player.name
+	}
+	gs.occupants[seatId] = ""
+	s.ReportPlayerLeft(g, player.name)
+	s.BroadcastMessage(g, fmt.Sprintf("A %s bot will play for %s until they rejoin", s.opts.ReplacementBot, gs.leftBy[seatId]))
+	go s.startBot(g.Id(), s.opts.ReplacementBot)
+	return true
+}
+
+// Starts a bot for gameId. A bot that can't replace a player who left ends the game.
+func (s *cardGameService) startBot(gameId, playerType string) error {
+	err := s.opts.Bots.StartBot(botService{s, gameId}, gameId, playerType)
+	if err == nil {
+		return nil
+	}
+	log.Printf("Couldn't start %s bot for game %s: %v", playerType, gameId, err)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if gs, ok := s.games[gameId]; ok && gs.game.Phase() == game.Playing {
+		for _, occupant := range gs.occupants {
+			if occupant == "" {
+				s.abortGame(gs.game)
+				break
+			}
+		}
+	}
+	return err
+}
+
+// Starts a bot the host asked for, giving back its invite if it can't start.
+func (s *cardGameService) startInvitedBot(gameId, playerType string) {
+	if err := s.startBot(gameId, playerType); err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if gs, ok := s.games[gameId]; ok && gs.botInvites > 0 {
+		gs.botInvites--
+	}
+}
+
 func (s *cardGameService) abortGame(g game.Game) {
 	g.Abort()
 	s.ReportGameAborted(g)
@@ -174,34 +473,108 @@ func (s *cardGameService) deleteGame(gameId string) {
 		return
 	}
 	// Disconnect players from deleted game.
-	for playerId, _ := range g.reportChs {
+	for playerId := range g.listeners {
+		if player, ok := s.players[playerId]; ok {
+			delete(player.gameIds, gameId)
+		}
+	}
+	for playerId := range g.disconnected {
 		if player, ok := s.players[playerId]; ok {
 			delete(player.gameIds, gameId)
+			if player.attachments == 0 && len(player.gameIds) == 0 {
+				// Restored for this game, and never resumed.
+				s.deletePlayer(playerId)
+			}
 		}
 	}
 	log.Printf("Deleted game %s\n", gameId)
 	delete(s.games, gameId)
-	s.reportGameDeleted(gameId)
+	s.unsaveGame(gameId)
+	if !g.private {
+		s.reportGameDeleted(gameId)
+	}
 }
 
 func (s *cardGameService) Register(req *pb.RegisterRequest, resp pb.CardGameService_RegisterServer) error {
+	return s.register(req.GetName(), "", resp)
+}
+
+// Registers a person, or a bot started for botGameId if it's set.
+func (s *cardGameService) register(name, botGameId string, resp pb.CardGameService_RegisterServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.addPlayer(name, botGameId != "")
+	p.botGameId = botGameId
+	return s.streamRegistryActivity(p, resp)
+}
+
+// The service as a bot started for gameId sees it. Only sessions registered through it are
+// bots, so remote clients can't register as bots.
+type botService struct {
+	*cardGameService
+	gameId string
+}
+
+func (b botService) Register(req *pb.RegisterRequest, resp pb.CardGameService_RegisterServer) error {
+	return b.register(req.GetName(), b.gameId, resp)
+}
+
+func (s *cardGameService) ResumeSession(req *pb.ResumeSessionRequest, resp pb.CardGameService_ResumeSessionServer) error {
 	s.mu.Lock()
-	p := s.addPlayer(req.GetName())
+	defer s.mu.Unlock()
+	p := s.playerWithToken(req.GetResumeToken())
+	if p == nil {
+		return fmt.Errorf("no session to resume, it may have expired")
+	}
+	if p.listening {
+		// Its stream has dropped without the server noticing yet. Closing its channel ends it.
+		close(p.reportCh)
+	}
+	// Anything left over from before the disconnect is superseded by the full games list.
+	p.reportCh = make(chan registryActivityReport, 4)
+	log.Printf("Player %s resumed their session", p.id)
+	return s.streamRegistryActivity(p, resp)
+}
+
+// Streams p's registry activity to resp until the stream ends, then deletes p's session,
+// after the grace period if there is one.
+// Called with s.mu held, which is released while streaming.
+func (s *cardGameService) streamRegistryActivity(p *playerSession, resp pb.CardGameService_RegisterServer) error {
+	p.listening = true
+	p.attachments++
+	attachment := p.attachments
 	ch := p.reportCh
-	ch <- makeReportSessionCreatedActivity(p.id)
-	ch <- makeFullGamesListActivity(maps.Keys(s.games))
+	ch <- makeReportSessionCreatedActivity(p.id, p.resumeToken)
+	ch <- makeFullGamesListActivity(s.publicGameIds())
 	s.mu.Unlock()
-	err := reportRegistryActivityToListener(p.reportCh, resp)
+	err := reportRegistryActivityToListener(ch, resp)
 	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.deletePlayer(p.id)
+	if s.players[p.id] != p || p.attachments != attachment {
+		// Deleted, or resumed on another stream.
+		return err
+	}
+	p.listening = false
+	grace := s.opts.ResumeGracePeriod
+	if grace <= 0 {
+		s.deletePlayer(p.id)
+		return err
+	}
+	log.Printf("Player %s disconnected, keeping their session for %v", p.id, grace)
+	time.AfterFunc(grace, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if !p.listening && p.attachments == attachment {
+			s.deletePlayer(p.id)
+		}
+	})
 	return err
 }
 
-func makeReportSessionCreatedActivity(sessionId string) registryActivityReport {
+func makeReportSessionCreatedActivity(sessionId, resumeToken string) registryActivityReport {
 	return &pb.RegistryActivity_SessionCreated_{
 		SessionCreated: &pb.RegistryActivity_SessionCreated{
-			SessionId: sessionId,
+			SessionId:   sessionId,
+			ResumeToken: resumeToken,
 		},
 	}
 }
@@ -219,11 +592,17 @@ func (s *cardGameService) ListGames(ctx context.Context, req *pb.ListGamesReques
 	filter := makeGameFilter(req.GetPhase())
 	var games []*pb.ListGamesResponse_GameSummary
 	for _, gs := range s.games {
-		if filter(gs.game) {
+		if !gs.private && filter(gs.game) {
+			names := gs.game.PlayerNames()
+			var ratings []float64
+			for _, name := range names {
+				ratings = append(ratings, s.ratingOf(name))
+			}
 			games = append(games, &pb.ListGamesResponse_GameSummary{
-				Id:          gs.game.Id(),
-				Phase:       gs.game.Phase().ToProto(),
-				PlayerNames: gs.game.PlayerNames(),
+				Id:            gs.game.Id(),
+				Phase:         gs.game.Phase().ToProto(),
+				PlayerNames:   names,
+				PlayerRatings: ratings,
 			})
 		}
 	}
@@ -248,9 +627,44 @@ func makeGameFilter(phases []pb.GameState_Phase) func(game.Game) bool {
 }
 
 func (s *cardGameService) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+	var hands []cards.Cards
+	for _, h := range req.GetHands() {
+		hand, err := cards.ParseCards(h.GetCards())
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, hand)
+	}
+	plays, err := cards.ParseCards(req.GetPlays())
+	if err != nil {
+		return nil, err
+	}
+	if len(plays) > 0 && len(hands) == 0 {
+		return nil, fmt.Errorf("can't replay plays without the hands they were played from")
+	}
+	if len(plays) >= 52 {
+		return nil, fmt.Errorf("%d plays leave no cards to play", len(plays))
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	gs := s.addGame()
+	host := req.GetSessionId()
+	if _, ok := s.players[host]; host != "" && !ok {
+		return nil, fmt.Errorf("sessionId %s not found", host)
+	}
+	if len(req.GetReservedNames()) >= tableSize {
+		return nil, fmt.Errorf("can't reserve %d seats at a table of %d", len(req.GetReservedNames()), tableSize)
+	}
+	gs, err := s.addGame(hands, req.GetVisibility() == pb.CreateGameRequest_Private)
+	if err != nil {
+		return nil, err
+	}
+	gs.presetPlays = plays
+	gs.joinCode = req.GetJoinCode()
+	gs.host = host
+	gs.partnerships = req.GetPartnerships()
+	for _, name := range req.GetReservedNames() {
+		gs.reservedNames[name] = struct{}{}
+	}
 	return &pb.CreateGameResponse{
 		GameId: gs.game.Id(),
 	}, nil
@@ -269,29 +683,162 @@ func (s *cardGameService) JoinGame(req *pb.JoinGameRequest, resp pb.CardGameServ
 		return fmt.Errorf("game %s not found", gameId)
 	}
 	g := gs.game
-	player.gameIds[gameId] = struct{}{}
-	if !g.AcceptingMorePlayers() {
-		return fmt.Errorf("game %s is full", gameId)
+	if err := gs.checkEntry(player, req.GetJoinCode()); err != nil {
+		return err
 	}
-	g.AddPlayer(player.name, player.id)
-	s.ReportPlayerJoined(g, player.name)
-	if g.IsEnoughPlayersToStart() {
-		go s.triggerStartWhenPlayersReady(gs)
+	player.gameIds[gameId] = struct{}{}
+	seatId, err := s.seatPlayer(gs, player)
+	if err != nil {
+		return err
 	}
 	log.Printf("Player %s joined game %s", sessionId, gameId)
 
-	ch := make(chan gameActivityReport, 4)
-	gs.reportChs[sessionId] = ch
-	s.mu.Unlock()
-	reportGameActivityToListener(gameId, ch, resp)
+	gs.sent[sessionId] = nil
+	l := gs.addListener(sessionId)
+	if g.Phase() == game.Playing && g.NextPlayerId() == seatId {
+		// Someone taking over a seat during play may need to play straight away.
+		s.ReportNextTurn(g)
+	}
+	s.streamGameActivity(gs, sessionId, l, 0, resp)
+	return nil
+}
+
+func (s *cardGameService) RejoinGame(req *pb.RejoinGameRequest, resp pb.CardGameService_RejoinGameServer) error {
 	s.mu.Lock()
-	delete(gs.reportChs, sessionId)
-	close(ch)
-	// TODO: move this to when player listening activity channel is closed, not game.
-	s.handleLeaveGame(sessionId, gameId)
+	defer s.mu.Unlock()
+	player := s.playerWithToken(req.GetResumeToken())
+	if player == nil {
+		return fmt.Errorf("no session to resume, it may have expired")
+	}
+	sessionId := player.id
+	gameId := req.GetGameId()
+	gs, ok := s.games[gameId]
+	if !ok {
+		return fmt.Errorf("game %s not found", gameId)
+	}
+	_, away := gs.disconnected[sessionId]
+	_, listening := gs.listeners[sessionId]
+	if !away && !(listening && gs.game.HasPlayer(gs.seatOf(sessionId))) {
+		return fmt.Errorf("player %s isn't playing in game %s", sessionId, gameId)
+	}
+	sent := gs.sent[sessionId]
+	received := int(req.GetActivitiesReceived())
+	if received < 0 {
+		return fmt.Errorf("player %s can't have received %d activities", sessionId, received)
+	}
+	if received > len(sent) {
+		// The last activity before a restart may not have been saved. Pad what was sent
+		// so it lines up with what they received, in case they need to rejoin again.
+		sent = append(sent, make([]gameActivityReport, received-len(sent))...)
+		gs.sent[sessionId] = sent
+	}
+	delete(gs.disconnected, sessionId)
+	// If it's still listening, its stream has dropped without the server noticing yet.
+	gs.removeListener(sessionId)
+	log.Printf("Player %s rejoined game %s, resending %d activities", sessionId, gameId, len(sent)-received)
+	l := gs.addListener(sessionId)
+	s.BroadcastMessage(gs.game, fmt.Sprintf("%s is back", player.name))
+	s.streamGameActivity(gs, sessionId, l, received, resp)
 	return nil
 }
 
+// Streams sessionId's activity from sent[next] to resp until the game ends or the stream drops.
+// Called with s.mu held, which is released while sending.
+func (s *cardGameService) streamGameActivity(gs *gameSession, sessionId string, l *gameListener, next int, resp pb.CardGameService_ObserveGameServer) {
+	gameId := gs.game.Id()
+	err := s.reportGameActivityToListener(gs, sessionId, l, next, resp)
+	if gs.listeners[sessionId] != l {
+		// Removed, or replaced by a rejoined stream.
+		return
+	}
+	delete(gs.listeners, sessionId)
+	if err != nil && s.waitForRejoin(gs, sessionId) {
+		return
+	}
+	s.handleLeaveGame(sessionId, gameId)
+}
+
+// Keeps the seat of a player whose stream dropped during play for the grace period.
+// Returns false if they should leave the game now instead.
+func (s *cardGameService) waitForRejoin(gs *gameSession, sessionId string) bool {
+	g := gs.game
+	grace := s.opts.ResumeGracePeriod
+	player, ok := s.players[sessionId]
+	if !ok || grace <= 0 || g.Phase() != game.Playing || !g.HasPlayer(gs.seatOf(sessionId)) {
+		return false
+	}
+	disconnectedAt := time.Now()
+	gs.disconnected[sessionId] = disconnectedAt
+	log.Printf("Player %s disconnected from game %s", sessionId, g.Id())
+	s.BroadcastMessage(g, fmt.Sprintf("%s lost their connection, waiting up to %v for them to return", player.name, grace))
+	time.AfterFunc(grace, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if t, ok := gs.disconnected[sessionId]; ok && t == disconnectedAt {
+			delete(gs.disconnected, sessionId)
+			s.handleLeaveGame(sessionId, g.Id())
+		}
+	})
+	return true
+}
+
+// Gives player a seat in the game and returns the seat's player id.
+func (s *cardGameService) seatPlayer(gs *gameSession, player *playerSession) (string, error) {
+	g := gs.game
+	switch g.Phase() {
+	case game.Preparing:
+		if !g.AcceptingMorePlayers() {
+			return "", fmt.Errorf("game %s is full", g.Id())
+		}
+		if _, ok := gs.reserved[player.id]; ok {
+			delete(gs.reserved, player.id)
+		} else if _, ok := gs.reservedNames[player.name]; ok && !player.isBot {
+			delete(gs.reservedNames, player.name)
+		} else if gs.openSeats() == 0 {
+			return "", fmt.Errorf("the rest of the seats in game %s are reserved", g.Id())
+		}
+		g.AddPlayer(player.name, player.id, player.isBot)
+		s.ReportPlayerJoined(g, player.name)
+		if g.IsEnoughPlayersToStart() {
+			go s.triggerStartWhenPlayersReady(gs)
+		}
+		return player.id, nil
+	case game.Playing:
+		return s.takeOverSeat(gs, player)
+	}
+	return "", fmt.Errorf("game %s is full", g.Id())
+}
+
+// Seats player in a game that's being played. A person takes back the seat they left,
+// which needs the session they left it with, and a bot takes an empty seat.
+func (s *cardGameService) takeOverSeat(gs *gameSession, player *playerSession) (string, error) {
+	g := gs.game
+	// A seat keeps the id of the session that started in it.
+	if _, left := gs.leftBy[player.id]; left && !player.isBot {
+		seatId := player.id
+		if bot := gs.occupants[seatId]; bot != "" {
+			// Ending the game for the bot stops it.
+			gs.send(bot, &pb.GameActivity_GameAborted_{})
+		}
+		delete(gs.leftBy, seatId)
+		gs.occupants[seatId] = player.id
+		g.UpdatePlayer(seatId, player.name, false)
+		s.ReportPlayerJoined(g, player.name)
+		return seatId, nil
+	}
+	if player.isBot {
+		for seatId, occupant := range gs.occupants {
+			if occupant == "" {
+				gs.occupants[seatId] = player.id
+				g.UpdatePlayer(seatId, player.name, true)
+				s.ReportPlayerJoined(g, player.name)
+				return seatId, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("game %s is full", g.Id())
+}
+
 func (s *cardGameService) ObserveGame(req *pb.ObserveGameRequest, resp pb.CardGameService_ObserveGameServer) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -306,19 +853,15 @@ func (s *cardGameService) ObserveGame(req *pb.ObserveGameRequest, resp pb.CardGa
 	if !ok {
 		return fmt.Errorf("game %s not found", gameId)
 	}
+	if err := gs.checkViewer(sessionId, req.GetJoinCode()); err != nil {
+		return err
+	}
 	player.gameIds[gameId] = struct{}{}
 	log.Printf("Player %s observing game %s", sessionId, gameId)
 
-	// TODO: deduplicate this
-	ch := make(chan gameActivityReport, 4)
-	gs.reportChs[sessionId] = ch
-	s.mu.Unlock()
-	reportGameActivityToListener(gameId, ch, resp)
-	s.mu.Lock()
-	delete(gs.reportChs, sessionId)
-	close(ch)
-	// TODO: move this to when player listening activity channel is closed, not game.
-	s.handleLeaveGame(sessionId, gameId)
+	gs.sent[sessionId] = nil
+	l := gs.addListener(sessionId)
+	s.streamGameActivity(gs, sessionId, l, 0, resp)
 	return nil
 }
 
@@ -332,11 +875,23 @@ func (s *cardGameService) triggerStartWhenPlayersReady(gs *gameSession) {
 		select {
 		case <-ticker.C:
 			s.mu.Lock()
+			if g.Phase() != game.Preparing || !g.IsEnoughPlayersToStart() {
+				// Started by another trigger, or someone has left.
+				s.mu.Unlock()
+				return
+			}
 			// log.Printf("Checking if players are ready for game %s\n", g.Id())
 			if len(gs.game.UnconfirmedPlayerIds()) == 0 {
 				log.Printf("Starting game %s\n", g.Id())
 				gs.game.StartGame()
 				s.ReportGameStarted(g)
+				if err := s.playPresetPlays(gs); err != nil {
+					log.Printf("Game %s can't replay its plays: %v", g.Id(), err)
+					s.BroadcastMessage(g, fmt.Sprintf("Can't replay the plays: %v", err))
+					s.abortGame(g)
+					s.mu.Unlock()
+					return
+				}
 				s.ReportNextTurn(g)
 				s.mu.Unlock()
 				return
@@ -355,6 +910,17 @@ func (s *cardGameService) triggerStartWhenPlayersReady(gs *gameSession) {
 	}
 }
 
+// Plays the cards that a game was created with, each by whoever holds it.
+func (s *cardGameService) playPresetPlays(gs *gameSession) error {
+	g := gs.game
+	for _, c := range gs.presetPlays {
+		if err := g.HandlePlayCard(g.NextPlayerId(), c, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *cardGameService) GameAction(ctx context.Context, req *pb.GameActionRequest) (*pb.Status, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -369,6 +935,14 @@ func (s *cardGameService) GameAction(ctx context.Context, req *pb.GameActionRequ
 	case *pb.GameActionRequest_PlayCard:
 		card, _ := cards.ParseCard(r.PlayCard.GetCard())
 		err = s.handlePlayCard(sessionId, gameId, card)
+	case *pb.GameActionRequest_AddBot:
+		err = s.handleAddBot(sessionId, gameId, r.AddBot.GetPlayerType())
+	case *pb.GameActionRequest_KickPlayer:
+		err = s.handleKickPlayer(sessionId, gameId, r.KickPlayer.GetName())
+	case *pb.GameActionRequest_StartGame:
+		err = s.handleStartGame(sessionId, gameId, r.StartGame.GetPlayerType())
+	case *pb.GameActionRequest_ChooseSeat:
+		err = s.handleChooseSeat(sessionId, gameId, cards.SeatFromProto(r.ChooseSeat.GetSeat()))
 	default:
 		return nil, fmt.Errorf("GameActionRequest has unexpected type %T", r)
 	}
@@ -386,6 +960,54 @@ func (s *cardGameService) handleReadyToStartGame(sessionId, gameId string) error
 	return g.game.ConfirmPlayerReadyToStart(sessionId)
 }
 
+func (s *cardGameService) handleAddBot(sessionId, gameId, playerType string) error {
+	gs, found := s.games[gameId]
+	if !found {
+		return fmt.Errorf("no game %s found", gameId)
+	}
+	if gs.host != "" && sessionId != gs.host {
+		return fmt.Errorf("only the host of game %s can add bots", gameId)
+	}
+	if s.opts.Bots == nil {
+		return fmt.Errorf("this server doesn't host bots")
+	}
+	if err := s.opts.Bots.CheckPlayerType(playerType); err != nil {
+		return err
+	}
+	if gs.game.Phase() != game.Preparing {
+		return fmt.Errorf("bots can only be added before game %s starts", gameId)
+	}
+	if !gs.game.AcceptingMorePlayers() {
+		return fmt.Errorf("game %s is full", gameId)
+	}
+	if gs.openSeats()-gs.botInvites <= 0 {
+		return fmt.Errorf("the rest of the seats in game %s are reserved or waiting for bots", gameId)
+	}
+	gs.botInvites++
+	go s.startInvitedBot(gameId, playerType)
+	return nil
+}
+
+func (s *cardGameService) handleChooseSeat(sessionId, gameId string, seat cards.Seat) error {
+	gs, found := s.games[gameId]
+	if !found {
+		return fmt.Errorf("no game %s found", gameId)
+	}
+	player, ok := s.players[sessionId]
+	if !ok {
+		return fmt.Errorf("sessionId %s not found", sessionId)
+	}
+	if err := gs.game.ChooseSeat(sessionId, seat); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("%s is sitting %s", player.name, seat.Name())
+	if gs.partnerships {
+		msg = fmt.Sprintf("%s is sitting %s, on team %d", player.name, seat.Name(), seat.Team())
+	}
+	s.BroadcastMessage(gs.game, msg)
+	return nil
+}
+
 func (s *cardGameService) handleLeaveGame(sessionId, gameId string) error {
 	return s.removePlayerFromGame(sessionId, gameId)
 }
@@ -396,7 +1018,13 @@ func (s *cardGameService) handlePlayCard(sessionId, gameId string, card cards.Ca
 		return fmt.Errorf("no game %s found", gameId)
 	}
 	g := gs.game
-	err := g.HandlePlayCard(sessionId, card, s)
+	seatId := gs.seatOf(sessionId)
+	if p, ok := s.players[sessionId]; ok && p.isBot && !g.HasPlayer(seatId) {
+		// A bot that has just handed its seat back may still be finishing its turn.
+		log.Printf("Ignoring play from bot %s, which has left game %s", sessionId, gameId)
+		return nil
+	}
+	err := g.HandlePlayCard(seatId, card, s)
 	if err != nil {
 		return err
 	}
@@ -420,7 +1048,25 @@ func (s *cardGameService) GetGameState(ctx context.Context, req *pb.GameStateReq
 	if !found {
 		return nil, fmt.Errorf("no game %s found", gameId)
 	}
-	return gs.game.GetGameState(sessionId)
+	if err := gs.checkViewer(sessionId, req.GetJoinCode()); err != nil {
+		return nil, err
+	}
+	seatId := gs.seatOf(sessionId)
+	if seatId == "" && sessionId != "" {
+		// Without a seat they'd be shown every hand, like an observer.
+		return nil, fmt.Errorf("player %s has left game %s", sessionId, gameId)
+	}
+	state, err := gs.game.GetGameState(seatId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range state.GetPlayers() {
+		p.Rating = s.ratingOf(p.GetName())
+		if gs.partnerships {
+			p.Team = int32(cards.SeatFromProto(p.GetSeat()).Team())
+		}
+	}
+	return state, nil
 }
 
 // Broadcasts message to all clients.
@@ -451,31 +1097,46 @@ func (s *cardGameService) ReportGameReadyToStart(g game.Game) {
 	}
 	activity := &pb.GameActivity_GameReadyToStart_{}
 	for _, pid := range g.UnconfirmedPlayerIds() {
-		if ch, ok := gs.reportChs[pid]; ok {
-			ch <- activity
-		}
+		gs.send(pid, activity)
 	}
 }
 func (s *cardGameService) ReportGameStarted(g game.Game) {
+	if gs, ok := s.games[g.Id()]; ok {
+		s.startHistory(gs)
+	}
 	s.reportGameActivityToAll(
 		g,
 		&pb.GameActivity_GameStarted_{})
 }
-func (s *cardGameService) ReportCardPlayed(g game.Game) {
+func (s *cardGameService) ReportCardPlayed(g game.Game, card cards.Card, playerId, playerName string) {
+	cardPlayed := &pb.GameActivity_CardPlayed{
+		Card:       card.String(),
+		PlayerId:   playerId,
+		PlayerName: playerName,
+	}
+	if gs, ok := s.games[g.Id()]; ok {
+		gs.recordEvent(&pb.GameHistory_Event{Type: &pb.GameHistory_Event_CardPlayed{CardPlayed: cardPlayed}})
+	}
 	s.reportGameActivityToAll(
 		g,
-		&pb.GameActivity_CardPlayed_{})
+		&pb.GameActivity_CardPlayed_{
+			CardPlayed: cardPlayed,
+		})
 }
 func (s *cardGameService) ReportTrickCompleted(g game.Game, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) {
+	trickCompleted := &pb.GameActivity_TrickCompleted{
+		Trick:       trick.Strings(),
+		WinningCard: winningCard.String(),
+		WinnerId:    winnerId,
+		WinnerName:  winnerName,
+	}
+	if gs, ok := s.games[g.Id()]; ok {
+		gs.recordEvent(&pb.GameHistory_Event{Type: &pb.GameHistory_Event_TrickCompleted{TrickCompleted: trickCompleted}})
+	}
 	s.reportGameActivityToAll(
 		g,
 		&pb.GameActivity_TrickCompleted_{
-			TrickCompleted: &pb.GameActivity_TrickCompleted{
-				Trick:       trick.Strings(),
-				WinningCard: winningCard.String(),
-				WinnerId:    winnerId,
-				WinnerName:  winnerName,
-			},
+			TrickCompleted: trickCompleted,
 		})
 }
 func (s *cardGameService) reportGameCreated(gameId string) {
@@ -495,11 +1156,18 @@ func (s *cardGameService) reportGameDeleted(gameId string) {
 		})
 }
 func (s *cardGameService) ReportGameFinished(g game.Game) {
+	if gs, ok := s.games[g.Id()]; ok {
+		s.finishHistory(gs)
+		s.updateRatings(gs)
+	}
 	s.reportGameActivityToAll(
 		g,
 		&pb.GameActivity_GameFinished_{})
 }
 func (s *cardGameService) ReportGameAborted(g game.Game) {
+	if gs, ok := s.games[g.Id()]; ok {
+		s.finishHistory(gs)
+	}
 	s.reportGameActivityToAll(
 		g,
 		&pb.GameActivity_GameAborted_{})
@@ -510,53 +1178,70 @@ func (s *cardGameService) ReportNextTurn(g game.Game) {
 		log.Printf("ReportNextTurn: no such gameId %s", g.Id())
 		return
 	}
-	pId := g.NextPlayerId()
-	ch, ok := gs.reportChs[pId]
-	if !ok {
+	s.scheduleSave(gs)
+	pId := gs.occupantOf(g.NextPlayerId())
+	_, listening := gs.listeners[pId]
+	if _, away := gs.disconnected[pId]; !listening && !away {
 		log.Printf("No such playerId %s", pId)
 		return
 	}
-	yourTurn := &pb.GameActivity_YourTurn_{}
-	ch <- yourTurn
+	gs.send(pId, &pb.GameActivity_YourTurn_{})
 }
 func (s *cardGameService) reportGameActivityToAll(g game.Game, activity gameActivityReport) {
 	if gs, ok := s.games[g.Id()]; ok {
-		for _, ch := range gs.reportChs {
-			ch <- activity
+		s.scheduleSave(gs)
+		for sessionId := range gs.listeners {
+			gs.send(sessionId, activity)
+		}
+		for sessionId := range gs.disconnected {
+			gs.send(sessionId, activity)
 		}
 	}
 }
 func (s *cardGameService) reportRegistryActivityToAll(activity registryActivityReport) {
 	for _, p := range s.players {
-		p.reportCh <- activity
+		// A resumed session gets the full games list instead.
+		if p.listening {
+			p.reportCh <- activity
+		}
 	}
 }
 
-// Handles both JoinGame and ObserveGame streams.
-func reportGameActivityToListener(gameId string, ch chan gameActivityReport, listener pb.CardGameService_ObserveGameServer) error {
+// Handles JoinGame, RejoinGame and ObserveGame streams. Sends sessionId's activity from sent[next].
+// Called with s.mu held, which is released while sending and waiting.
+func (s *cardGameService) reportGameActivityToListener(gs *gameSession, sessionId string, l *gameListener, next int, listener pb.CardGameService_ObserveGameServer) error {
+	gameId := gs.game.Id()
 	for {
-		select {
-		case gameActivityType := <-ch:
-			activity := &pb.GameActivity{GameId: gameId, Type: gameActivityType}
-			err := listener.Send(activity)
+		if gs.listeners[sessionId] != l {
+			// Removed, or replaced by a rejoined stream.
+			return nil
+		}
+		if sent := gs.sent[sessionId]; next < len(sent) {
+			gameActivityType := sent[next]
+			next++
+			s.mu.Unlock()
+			err := listener.Send(&pb.GameActivity{GameId: gameId, Type: gameActivityType})
+			s.mu.Lock()
 			if err != nil {
 				return err
 			}
-			shouldStopListening := func() bool {
-				switch gameActivityType.(type) {
-				case *pb.GameActivity_GameFinished_,
-					*pb.GameActivity_GameAborted_:
-					return true
-				default:
-					return false
-				}
-			}()
-			if shouldStopListening {
+			switch gameActivityType.(type) {
+			case *pb.GameActivity_GameFinished_,
+				*pb.GameActivity_GameAborted_:
 				// Game is over. Close this reporting request.
 				return nil
 			}
+			continue
+		}
+		s.mu.Unlock()
+		select {
+		case <-l.wake:
+		case <-l.stop:
 		case <-listener.Context().Done():
-			return listener.Context().Err()
+		}
+		s.mu.Lock()
+		if err := listener.Context().Err(); err != nil {
+			return err
 		}
 	}
 }
@@ -564,7 +1249,11 @@ func reportGameActivityToListener(gameId string, ch chan gameActivityReport, lis
 func reportRegistryActivityToListener(ch chan registryActivityReport, listener pb.CardGameService_RegisterServer) error {
 	for {
 		select {
-		case registryActivityType := <-ch:
+		case registryActivityType, ok := <-ch:
+			if !ok {
+				// The session was deleted or resumed on another stream.
+				return nil
+			}
 			activity := &pb.RegistryActivity{Type: registryActivityType}
 			err := listener.Send(activity)
 			if err != nil {
@@ -0,0 +1,1338 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	"github.com/mpsalisbury/cards/pkg/client"
+	hearts "github.com/mpsalisbury/cards/pkg/game/hearts/player"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+	"github.com/mpsalisbury/cards/pkg/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestMain(m *testing.M) {
+	// The server logs every trick.
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+func newConnection() client.Connection {
+	svc := server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), ReplacementBot: "basic"})
+	return client.ConnectToService(svc, false)
+}
+
+// Plays basic strategy, but leaves the game instead of taking its turn once leaveAt turns have been played.
+// If it rejoins, it carries on playing.
+type leavingPlayer struct {
+	client.GameCallbacks
+	leaveAt int
+	turns   int
+	left    chan struct{}
+}
+
+func (p *leavingPlayer) HandleYourTurn(s client.Session, gameId string) error {
+	if p.turns == p.leaveAt {
+		p.turns++
+		defer close(p.left)
+		return s.LeaveGame(context.Background(), gameId)
+	}
+	p.turns++
+	return p.GameCallbacks.HandleYourTurn(s, gameId)
+}
+
+// Joins gameId as a basic player named name and returns its session.
+func join(t *testing.T, conn client.Connection, wg *sync.WaitGroup, gameId, name string, callbacks client.GameCallbacks) client.Session {
+	t.Helper()
+	if callbacks == nil {
+		var err error
+		if callbacks, err = hearts.NewPlayerFromFlag("basic", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	session, err := conn.Register(context.Background(), name, callbacks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := session.JoinGame(context.Background(), wg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	return session
+}
+
+func waitForGame(t *testing.T, wg *sync.WaitGroup) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("game didn't finish")
+	}
+}
+
+// Returns the final state of the game, with the seat names and which seats are bots.
+func finalState(t *testing.T, conn client.Connection, gameId string) client.GameState {
+	t.Helper()
+	gs, err := conn.GetGameState(context.Background(), gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gs.Phase != client.Completed {
+		t.Fatalf("game is %s, want Completed", gs.Phase)
+	}
+	return gs
+}
+
+func TestAddBots(t *testing.T) {
+	conn := newConnection()
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	session := join(t, conn, wg, gameId, "ann", nil)
+	if err := session.AddBot(ctx, gameId, "exec:/bin/sh"); err == nil {
+		t.Errorf("AddBot(exec) succeeded, want an error")
+	}
+	for _, botType := range []string{"basic", "novice", "random"} {
+		if err := session.AddBot(ctx, gameId, botType); err != nil {
+			t.Fatalf("AddBot(%s): %v", botType, err)
+		}
+	}
+	waitForGame(t, wg)
+
+	gs := finalState(t, conn, gameId)
+	bots := 0
+	for _, p := range gs.Players {
+		if p.IsBot {
+			bots++
+		}
+		if p.Name == "ann" && p.IsBot {
+			t.Errorf("ann is marked as a bot")
+		}
+	}
+	if bots != 3 {
+		t.Errorf("got %d bots, want 3: %v", bots, gs)
+	}
+}
+
+func TestBotReplacesPlayerWhoLeaves(t *testing.T) {
+	for _, rejoin := range []bool{false, true} {
+		conn := newConnection()
+		ctx := context.Background()
+		gameId, err := conn.CreateGame(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		basic, err := hearts.NewPlayerFromFlag("basic", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaver := &leavingPlayer{GameCallbacks: basic, leaveAt: 3, left: make(chan struct{})}
+		wg := new(sync.WaitGroup)
+		annSession := join(t, conn, wg, gameId, "ann", leaver)
+		join(t, conn, wg, gameId, "bob", nil)
+		join(t, conn, wg, gameId, "cat", nil)
+		join(t, conn, wg, gameId, "dan", nil)
+		<-leaver.left
+		// Only ann's own session can take her seat back.
+		if err := refusedJoin(t, conn, gameId, "ann", ""); err == nil {
+			t.Errorf("another session named ann took ann's seat")
+		}
+		if rejoin {
+			if err := annSession.JoinGame(ctx, wg, gameId); err != nil {
+				t.Fatal(err)
+			}
+		}
+		waitForGame(t, wg)
+
+		gs := finalState(t, conn, gameId)
+		var ann, bots int
+		for _, p := range gs.Players {
+			if p.IsBot {
+				bots++
+			}
+			if p.Name == "ann" {
+				ann++
+			}
+		}
+		if rejoin && (ann != 1 || bots != 0) {
+			t.Errorf("after ann rejoined, got %d seats named ann and %d bots, want 1 and 0: %v", ann, bots, gs)
+		}
+		if !rejoin && (ann != 0 || bots != 1) {
+			t.Errorf("after ann left, got %d seats named ann and %d bots, want 0 and 1: %v", ann, bots, gs)
+		}
+	}
+}
+
+// Plays basic strategy, drops its connection after dropAfter tricks, and counts what it sees.
+type droppingPlayer struct {
+	client.GameCallbacks
+	drop        func()
+	dropAfter   int
+	cardsPlayed int
+	tricks      int
+}
+
+func (p *droppingPlayer) HandleCardPlayed(s client.Session, gameId string, card cards.Card, playerId, playerName string) error {
+	p.cardsPlayed++
+	return p.GameCallbacks.HandleCardPlayed(s, gameId, card, playerId, playerName)
+}
+
+func (p *droppingPlayer) HandleTrickCompleted(s client.Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error {
+	p.tricks++
+	if p.tricks == p.dropAfter {
+		p.drop()
+	}
+	return p.GameCallbacks.HandleTrickCompleted(s, gameId, trick, winningCard, winnerId, winnerName)
+}
+
+// A gRPC connection to service whose network connections can be dropped.
+type flakyNetwork struct {
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (n *flakyNetwork) connect(t *testing.T, service pb.CardGameServiceServer) client.Connection {
+	t.Helper()
+	listener := bufconn.Listen(1 << 16)
+	grpcServer := grpc.NewServer()
+	pb.RegisterCardGameServiceServer(grpcServer, service)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		conn, err := listener.DialContext(ctx)
+		if err == nil {
+			n.mu.Lock()
+			n.conns = append(n.conns, conn)
+			n.mu.Unlock()
+		}
+		return conn, err
+	}
+	cc, err := grpc.Dial("bufnet", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.NewConnection(cc, pb.NewCardGameServiceClient(cc), false)
+}
+
+// Closes every connection made so far.
+func (n *flakyNetwork) drop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, conn := range n.conns {
+		conn.Close()
+	}
+	n.conns = nil
+}
+
+func TestPlayerRejoinsAfterConnectionDrops(t *testing.T) {
+	// Without a replacement bot, the game is aborted if ann doesn't get back in time.
+	svc := server.NewCardGameService(server.Options{ResumeGracePeriod: 20 * time.Second})
+	local := client.ConnectToService(svc, false)
+	network := new(flakyNetwork)
+	remote := network.connect(t, svc)
+	defer remote.Close()
+
+	gameId, err := local.CreateGame(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	basic, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ann := &droppingPlayer{GameCallbacks: basic, drop: network.drop, dropAfter: 4}
+	wg := new(sync.WaitGroup)
+	join(t, remote, wg, gameId, "ann", ann)
+	join(t, local, wg, gameId, "bob", nil)
+	join(t, local, wg, gameId, "cat", nil)
+	join(t, local, wg, gameId, "dan", nil)
+	waitForGame(t, wg)
+
+	gs := finalState(t, local, gameId)
+	for _, p := range gs.Players {
+		if p.IsBot {
+			t.Errorf("%s is a bot, want only the original players: %v", p.Name, gs)
+		}
+	}
+	// The activity ann missed while disconnected is sent when they rejoin.
+	if ann.cardsPlayed != 52 || ann.tricks != 13 {
+		t.Errorf("ann saw %d cards played in %d tricks, want 52 in 13", ann.cardsPlayed, ann.tricks)
+	}
+}
+
+// Plays basic strategy until stopAt tricks have been played, then calls stop instead of taking its turn.
+type stoppingPlayer struct {
+	client.GameCallbacks
+	stop   func()
+	stopAt int
+	tricks int
+}
+
+func (p *stoppingPlayer) HandleTrickCompleted(s client.Session, gameId string, trick cards.Cards, winningCard cards.Card, winnerId, winnerName string) error {
+	p.tricks++
+	return p.GameCallbacks.HandleTrickCompleted(s, gameId, trick, winningCard, winnerId, winnerName)
+}
+
+func (p *stoppingPlayer) HandleYourTurn(s client.Session, gameId string) error {
+	if p.tricks >= p.stopAt {
+		p.stop()
+		return nil
+	}
+	return p.GameCallbacks.HandleYourTurn(s, gameId)
+}
+
+func TestGameSurvivesRestart(t *testing.T) {
+	store, err := server.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := server.Options{Store: store, ResumeGracePeriod: time.Hour}
+	conn := client.ConnectToService(server.NewCardGameService(opts), false)
+	gameId, err := conn.CreateGame(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The players all disconnect partway through the game.
+	ctx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+	wg := new(sync.WaitGroup)
+	var tokens []string
+	for _, name := range []string{"ann", "bob", "cat", "dan"} {
+		basic, err := hearts.NewPlayerFromFlag("basic", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, err := conn.Register(ctx, name, &stoppingPlayer{GameCallbacks: basic, stop: disconnect, stopAt: 5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := session.JoinGame(ctx, wg, gameId); err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, session.GetResumeToken())
+	}
+	waitForGame(t, wg)
+	before, err := conn.GetGameState(context.Background(), gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.Phase != client.Playing {
+		t.Fatalf("game is %s before the restart, want Playing", before.Phase)
+	}
+	// Let the last save finish.
+	time.Sleep(100 * time.Millisecond)
+
+	restarted := client.ConnectToService(server.NewCardGameService(opts), false)
+	after, err := restarted.GetGameState(context.Background(), gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.String() != before.String() {
+		t.Fatalf("restored game is\n%v\nwant\n%v", after, before)
+	}
+	for _, token := range tokens {
+		basic, err := hearts.NewPlayerFromFlag("basic", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, err := restarted.ResumeSession(context.Background(), token, basic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := session.RejoinGame(context.Background(), wg, gameId); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	finalState(t, restarted, gameId)
+
+	// Finished games aren't kept.
+	for i := 0; ; i++ {
+		saved, err := store.LoadGames()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(saved) == 0 {
+			break
+		}
+		if i == 20 {
+			t.Fatalf("%d games still saved after the game finished", len(saved))
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestGameHistory(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "history")
+	history, err := server.NewFileHistory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), History: history}), false)
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	session := join(t, conn, wg, gameId, "ann", nil)
+	for i := 0; i < 3; i++ {
+		if err := session.AddBot(ctx, gameId, "basic"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	gs := finalState(t, conn, gameId)
+
+	// The history is kept by the store, so another server can read it.
+	history, err = server.NewFileHistory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn = client.ConnectToService(server.NewCardGameService(server.Options{History: history}), false)
+	h, err := conn.GetGameHistory(ctx, gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Phase != client.Completed || len(h.Plays) != 52 || len(h.Tricks) != 13 {
+		t.Fatalf("history is %s with %d plays and %d tricks, want Completed with 52 and 13", h.Phase, len(h.Plays), len(h.Tricks))
+	}
+	for i, p := range h.Players {
+		ps, err := gs.GetPlayerState(p.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.HandScores[i] != ps.HandScore {
+			t.Errorf("%s's recorded score is %d, want %d", p.Name, h.HandScores[i], ps.HandScore)
+		}
+		var played cards.Cards
+		for _, play := range h.Plays {
+			if play.PlayerId == p.Id {
+				played = append(played, play.Card)
+			}
+		}
+		played.Sort()
+		h.Hands[i].Sort()
+		if !played.Equals(h.Hands[i]) {
+			t.Errorf("%s played %s, but was dealt %s", p.Name, played, h.Hands[i])
+		}
+	}
+	for i, trick := range h.Tricks {
+		var cs cards.Cards
+		for _, play := range h.Plays[4*i : 4*i+4] {
+			cs = append(cs, play.Card)
+		}
+		if !cs.Equals(trick.Trick) {
+			t.Errorf("trick %d is %s, want the cards played %s", i+1, trick.Trick, cs)
+		}
+	}
+
+	// Game ids from clients can't reach files outside the history.
+	data, err := os.ReadFile(filepath.Join(dir, gameId+".history"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(parent, "secret.history"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.GetGameHistory(ctx, "../secret"); err == nil {
+		t.Errorf("GetGameHistory read a history outside the history directory")
+	}
+	// A file that can't be read doesn't hide the rest.
+	if err := os.WriteFile(filepath.Join(dir, "gbad.history"), []byte("not a history"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		filter client.PastGamesFilter
+		want   int
+	}{
+		{client.PastGamesFilter{}, 1},
+		{client.PastGamesFilter{PlayerName: "ann"}, 1},
+		{client.PastGamesFilter{PlayerName: "bob"}, 0},
+		{client.PastGamesFilter{StartedAfter: h.Start.Add(-time.Hour), StartedBefore: h.Start.Add(time.Hour)}, 1},
+		{client.PastGamesFilter{StartedAfter: h.Start.Add(time.Hour)}, 0},
+		{client.PastGamesFilter{StartedBefore: h.Start}, 0},
+	} {
+		games, err := conn.ListPastGames(ctx, test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(games) != test.want {
+			t.Errorf("ListPastGames(%+v) returned %d games, want %d", test.filter, len(games), test.want)
+		}
+	}
+}
+
+func TestCreateGameWithPlays(t *testing.T) {
+	conn := newConnection()
+	ctx := context.Background()
+	hands := cards.Deal(4)
+	if _, err := conn.CreateGame(ctx, client.WithPlays(cards.Cards{cards.C2c})); err == nil {
+		t.Errorf("CreateGame with plays and no deal succeeded, want an error")
+	}
+
+	// Continue a game after its first trick and a lead.
+	var leader int
+	for i, h := range hands {
+		if h.ContainsCard(cards.C2c) {
+			leader = i
+		}
+	}
+	plays := cards.Cards{cards.C2c}
+	for i := 1; i < 4; i++ {
+		h := hands[(leader+i)%4]
+		// Any club, or else any card but the Qs or a heart.
+		c := h.FilterBySuit(cards.Clubs)
+		if len(c) == 0 {
+			c = h.Filter(func(c cards.Card) bool { return c.Suit != cards.Hearts && c != cards.Cqs })
+		}
+		plays = append(plays, c[0])
+	}
+	gameId, err := conn.CreateGame(ctx, client.WithDeal(hands), client.WithPlays(plays))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	session := join(t, conn, wg, gameId, "ann", nil)
+	for i := 0; i < 3; i++ {
+		if err := session.AddBot(ctx, gameId, "basic"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	finalState(t, conn, gameId)
+	h, err := conn.GetGameHistory(ctx, gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range plays {
+		if h.Plays[i].Card != c {
+			t.Fatalf("play %d was %s, want %s", i, h.Plays[i].Card, c)
+		}
+	}
+}
+
+func TestAnalyzeGame(t *testing.T) {
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), Analyzer: hearts.NewGameAnalyzer()}), false)
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.AnalyzeGame(ctx, gameId); err == nil {
+		t.Errorf("AnalyzeGame of a game not yet played succeeded, want an error")
+	}
+	wg := new(sync.WaitGroup)
+	session := join(t, conn, wg, gameId, "ann", nil)
+	for i := 0; i < 3; i++ {
+		if err := session.AddBot(ctx, gameId, "basic"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	finalState(t, conn, gameId)
+	a, err := conn.AnalyzeGame(ctx, gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Id != gameId {
+		t.Errorf("analysis of game %s, want %s", a.Id, gameId)
+	}
+	if len(a.Players) != 4 || a.Players[0].Name != "ann" {
+		t.Errorf("analysis players are %v, want ann first of 4", a.Players)
+	}
+	if len(a.Decisions) == 0 {
+		t.Errorf("analysis has no decisions")
+	}
+}
+
+// Counts the games it's asked to analyze. Each analysis waits for release.
+type slowAnalyzer struct {
+	mu      sync.Mutex
+	calls   int
+	release chan struct{}
+}
+
+func (a *slowAnalyzer) AnalyzeGame(ctx context.Context, h *pb.GameHistory) (*pb.GameAnalysis, error) {
+	a.mu.Lock()
+	a.calls++
+	a.mu.Unlock()
+	select {
+	case <-a.release:
+		return &pb.GameAnalysis{GameId: h.GetGameId()}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (a *slowAnalyzer) numCalls() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.calls
+}
+
+func TestAnalyzeGameOnce(t *testing.T) {
+	history := server.NewMemoryHistory()
+	if err := history.AddGame(testHistory("g1", pb.GameState_Completed, nil, nil, []int32{0, 0, 0, 26})); err != nil {
+		t.Fatal(err)
+	}
+	analyzer := &slowAnalyzer{release: make(chan struct{})}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{History: history, Analyzer: analyzer}), false)
+
+	// A request that gives up stops its analysis.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := conn.AnalyzeGame(ctx, "g1"); err == nil {
+		t.Errorf("AnalyzeGame returned before its analysis finished")
+	}
+
+	// Requests at the same time share one analysis.
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if a, err := conn.AnalyzeGame(context.Background(), "g1"); err != nil || a.Id != "g1" {
+				t.Errorf("AnalyzeGame returned %+v, %v, want the analysis of g1", a, err)
+			}
+		}()
+	}
+	for analyzer.numCalls() < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(analyzer.release)
+	wg.Wait()
+	// And later requests are answered from the finished analysis.
+	if _, err := conn.AnalyzeGame(context.Background(), "g1"); err != nil {
+		t.Fatal(err)
+	}
+	if n := analyzer.numCalls(); n != 2 {
+		t.Errorf("analyzed g1 %d times, want 2", n)
+	}
+}
+
+// Returns the history of a game between ann, bob, cat and dan, with each trick won by the
+// seat at the same index of winners.
+func testHistory(gameId string, phase pb.GameState_Phase, tricks [][]string, winners []int, scores []int32) *pb.GameHistory {
+	h := &pb.GameHistory{GameId: gameId, Phase: phase}
+	for i, name := range []string{"ann", "bob", "cat", "dan"} {
+		h.Players = append(h.Players, &pb.GameHistory_Player{Id: fmt.Sprintf("%s-%d", gameId, i), Name: name})
+	}
+	for i, t := range tricks {
+		winner := h.Players[winners[i]]
+		h.Events = append(h.Events, &pb.GameHistory_Event{Type: &pb.GameHistory_Event_TrickCompleted{
+			TrickCompleted: &pb.GameActivity_TrickCompleted{Trick: t, WinnerId: winner.GetId(), WinnerName: winner.GetName()},
+		}})
+	}
+	if phase == pb.GameState_Completed {
+		h.Events = append(h.Events, &pb.GameHistory_Event{Type: &pb.GameHistory_Event_GameFinished{
+			GameFinished: &pb.GameHistory_GameFinished{HandScores: scores},
+		}})
+	}
+	return h
+}
+
+func TestPlayerStats(t *testing.T) {
+	history := server.NewMemoryHistory()
+	heartTricks := [][]string{{"Ah", "Kh", "Qh", "Jh"}, {"Th", "9h", "8h", "7h"}, {"6h", "5h", "4h", "3h"}, {"2h", "2c", "3c", "4c"}}
+	for _, h := range []*pb.GameHistory{
+		// ann shoots the moon.
+		testHistory("g1", pb.GameState_Completed, append(heartTricks, []string{"Qs", "2s", "3s", "4s"}), []int{0, 0, 0, 0, 0}, []int32{0, 26, 26, 26}),
+		// cat takes Q♠, and bob and dan tie for the win.
+		testHistory("g2", pb.GameState_Completed, append(heartTricks, []string{"Qs", "2s", "3s", "4s"}), []int{0, 0, 0, 2, 2}, []int32{12, 0, 14, 0}),
+		// Aborted games don't count.
+		testHistory("g3", pb.GameState_Aborted, [][]string{{"Qs", "2s", "3s", "4s"}}, []int{1}, nil),
+	} {
+		if err := history.AddGame(h); err != nil {
+			t.Fatal(err)
+		}
+	}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{History: history}), false)
+	ctx := context.Background()
+
+	got, err := conn.GetPlayerStats(ctx, "ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The games were added to the history directly, so they haven't changed ann's rating.
+	want := client.PlayerStats{Name: "ann", GamesPlayed: 2, Wins: 1, TotalHandScore: 12, MoonShots: 1, QueenOfSpadesTaken: 1, Rating: 1500}
+	if got != want {
+		t.Errorf("ann's stats are %+v, want %+v", got, want)
+	}
+	if got, err := conn.GetPlayerStats(ctx, "cat"); err != nil || got.QueenOfSpadesTaken != 1 || got.Wins != 0 {
+		t.Errorf("cat's stats are %+v, %v, want Q♠ taken once and no wins", got, err)
+	}
+	if _, err := conn.GetPlayerStats(ctx, "eve"); err == nil {
+		t.Errorf("GetPlayerStats of a player with no games succeeded, want an error")
+	}
+
+	for _, tc := range []struct {
+		opts client.LeaderboardOptions
+		want []string
+	}{
+		{client.LeaderboardOptions{}, []string{"ann", "bob", "dan", "cat"}},
+		{client.LeaderboardOptions{Order: client.ByAverageHandScore}, []string{"ann", "bob", "dan", "cat"}},
+		{client.LeaderboardOptions{Order: client.ByMoonShots, Limit: 2}, []string{"ann", "bob"}},
+		{client.LeaderboardOptions{MinGames: 3}, nil},
+	} {
+		players, err := conn.GetLeaderboard(ctx, tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, p := range players {
+			names = append(names, p.Name)
+		}
+		if fmt.Sprint(names) != fmt.Sprint(tc.want) {
+			t.Errorf("GetLeaderboard(%+v) ranks %v, want %v", tc.opts, names, tc.want)
+		}
+	}
+}
+
+func TestPlayerStatsCountNameOncePerGame(t *testing.T) {
+	history := server.NewMemoryHistory()
+	tricks := [][]string{{"Ah", "Kh", "Qh", "Jh"}, {"Qs", "2s", "3s", "4s"}}
+	h := testHistory("g1", pb.GameState_Completed, tricks, []int{1, 2}, []int32{0, 4, 13, 0})
+	// Three bots of the same type share a name.
+	for _, p := range h.Players[1:] {
+		p.Name = "basic bot"
+	}
+	if err := history.AddGame(h); err != nil {
+		t.Fatal(err)
+	}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{History: history}), false)
+	got, err := conn.GetPlayerStats(context.Background(), "basic bot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The bots' best seat is dan's, who tied with ann for the win.
+	want := client.PlayerStats{Name: "basic bot", GamesPlayed: 1, Wins: 1, TotalHandScore: 0, Rating: 1500}
+	if got != want {
+		t.Errorf("basic bot's stats are %+v, want %+v", got, want)
+	}
+}
+
+func TestRatings(t *testing.T) {
+	ratingsFile := filepath.Join(t.TempDir(), "ratings")
+	ratings, err := server.NewFileRatings(ratingsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history := server.NewMemoryHistory()
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), History: history, Ratings: ratings}), false)
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	session := join(t, conn, wg, gameId, "ann", nil)
+	// Bots of the same type would share a rating.
+	for _, botType := range []string{"basic", "random", "tracker"} {
+		if err := session.AddBot(ctx, gameId, botType); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	gs := finalState(t, conn, gameId)
+	// Everyone started at 1500, and what one player gains another loses.
+	total := 0.0
+	changed := false
+	for _, p := range gs.Players {
+		total += p.Rating
+		if p.Rating != 1500 {
+			changed = true
+		}
+	}
+	if !changed {
+		t.Errorf("no rating changed after a game")
+	}
+	if math.Abs(total-4*1500) > 1e-6 {
+		t.Errorf("ratings total %f, want %d", total, 4*1500)
+	}
+	var annRating float64
+	for _, p := range gs.Players {
+		if p.Name == "ann" {
+			annRating = p.Rating
+		}
+	}
+
+	// The ratings are loaded by the next server.
+	ratings, err = server.NewFileRatings(ratingsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn = client.ConnectToService(server.NewCardGameService(server.Options{History: history, Ratings: ratings}), false)
+	stats, err := conn.GetPlayerStats(ctx, "ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Rating != annRating {
+		t.Errorf("ann's rating is %f after a restart, want %f", stats.Rating, annRating)
+	}
+	gameId, err = conn.CreateGame(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	join(t, conn, new(sync.WaitGroup), gameId, "ann", nil)
+	var games []client.GameSummary
+	// Joining finishes in the background.
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if games, err = conn.ListGames(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if len(games) == 1 && len(games[0].Names) > 0 {
+			break
+		}
+	}
+	if len(games) != 1 || len(games[0].Ratings) != 1 || games[0].Ratings[0] != annRating {
+		t.Errorf("ListGames returned %+v, want ann's game with her rating %f", games, annRating)
+	}
+}
+
+func TestPlayerWhoLeavesIsRated(t *testing.T) {
+	ratings, err := server.NewFileRatings(filepath.Join(t.TempDir(), "ratings"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), ReplacementBot: "basic", Ratings: ratings})
+	conn := client.ConnectToService(svc, false)
+	gameId, err := conn.CreateGame(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	basic, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaver := &leavingPlayer{GameCallbacks: basic, leaveAt: 3, left: make(chan struct{})}
+	wg := new(sync.WaitGroup)
+	join(t, conn, wg, gameId, "ann", leaver)
+	join(t, conn, wg, gameId, "bob", nil)
+	join(t, conn, wg, gameId, "cat", nil)
+	join(t, conn, wg, gameId, "dan", nil)
+	<-leaver.left
+	waitForGame(t, wg)
+	finalState(t, conn, gameId)
+
+	// Ann is rated on the hand the bot finished for her, and the bot isn't rated at all.
+	r, err := ratings.LoadRatings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, rating := range r.GetRatings() {
+		names = append(names, rating.GetName())
+		if rating.GetGames() != 1 {
+			t.Errorf("%s has %d rated games, want 1", rating.GetName(), rating.GetGames())
+		}
+	}
+	sort.Strings(names)
+	if want := []string{"ann", "bob", "cat", "dan"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("rated %v, want %v", names, want)
+	}
+}
+
+// Registers a basic player called name and puts it in the queue, sending the game it's given to gameIds.
+func queue(t *testing.T, conn client.Connection, wg *sync.WaitGroup, name string, opts client.QueueOptions, gameIds chan<- string) {
+	t.Helper()
+	player, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := conn.Register(context.Background(), name, player)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		gameId, err := session.JoinQueue(context.Background(), wg, opts)
+		if err != nil {
+			t.Errorf("%s couldn't join the queue: %v", name, err)
+		}
+		gameIds <- gameId
+	}()
+}
+
+func TestJoinQueue(t *testing.T) {
+	svc := server.NewCardGameService(server.Options{Bots: hearts.NewBotHost(), QueueBot: "basic", QueueWait: 200 * time.Millisecond})
+	conn := client.ConnectToService(svc, false)
+	wg := new(sync.WaitGroup)
+	gameIds := make(chan string)
+	for _, name := range []string{"ann", "bob"} {
+		queue(t, conn, wg, name, client.QueueOptions{}, gameIds)
+	}
+	gameId := <-gameIds
+	if other := <-gameIds; other != gameId {
+		t.Fatalf("ann and bob were given games %s and %s, want the same game", gameId, other)
+	}
+	waitForGame(t, wg)
+	gs := finalState(t, conn, gameId)
+	var bots []string
+	for _, p := range gs.Players {
+		if p.IsBot {
+			bots = append(bots, p.Name)
+		}
+	}
+	if len(bots) != 2 {
+		t.Errorf("bots %v filled the table, want 2", bots)
+	}
+
+	player, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := conn.Register(context.Background(), "cat", player)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.JoinQueue(context.Background(), wg, client.QueueOptions{GameType: "bridge"}); err == nil {
+		t.Errorf("JoinQueue for bridge succeeded, want an error")
+	}
+}
+
+// A JoinQueue stream that sends the game the server assigns to gameIds, and never joins it.
+type assignedStream struct {
+	grpc.ServerStream
+	gameIds chan<- string
+}
+
+func (s assignedStream) Context() context.Context { return context.Background() }
+func (s assignedStream) Send(a *pb.QueueActivity) error {
+	if gameId := a.GetGameAssigned().GetGameId(); gameId != "" {
+		s.gameIds <- gameId
+	}
+	return nil
+}
+
+func TestQueuedPlayerWhoDoesntJoinIsReplaced(t *testing.T) {
+	svc := server.NewCardGameService(server.Options{
+		Bots:          hearts.NewBotHost(),
+		QueueBot:      "basic",
+		QueueWait:     200 * time.Millisecond,
+		QueueJoinWait: 200 * time.Millisecond,
+	})
+	conn := client.ConnectToService(svc, false)
+	ann, err := conn.Register(context.Background(), "ann", client.UnimplementedGameCallbacks{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gameIds := make(chan string)
+	go func() {
+		if err := svc.JoinQueue(&pb.JoinQueueRequest{SessionId: ann.GetSessionId()}, assignedStream{gameIds: gameIds}); err != nil {
+			t.Errorf("ann couldn't join the queue: %v", err)
+		}
+	}()
+	wg := new(sync.WaitGroup)
+	queue(t, conn, wg, "bob", client.QueueOptions{}, gameIds)
+	gameId := <-gameIds
+	if other := <-gameIds; other != gameId {
+		t.Fatalf("ann and bob were given games %s and %s, want the same game", gameId, other)
+	}
+	// Ann never joins, so a bot takes her seat once she's had her chance.
+	waitForGame(t, wg)
+	gs := finalState(t, conn, gameId)
+	var bots int
+	for _, p := range gs.Players {
+		if p.IsBot {
+			bots++
+		}
+	}
+	if bots != 3 {
+		t.Errorf("got %d bots, want 3: %v", bots, gs)
+	}
+}
+
+// Returns the same ratings each time, and doesn't save them.
+type fixedRatings map[string]float64
+
+func (fr fixedRatings) SaveRatings(r *pb.Ratings) error { return nil }
+func (fr fixedRatings) LoadRatings() (*pb.Ratings, error) {
+	r := &pb.Ratings{}
+	for name, rating := range fr {
+		r.Ratings = append(r.Ratings, &pb.Ratings_Rating{Name: name, Rating: rating, Games: 10})
+	}
+	return r, nil
+}
+
+func TestJoinQueueByRating(t *testing.T) {
+	svc := server.NewCardGameService(server.Options{
+		Bots:      hearts.NewBotHost(),
+		QueueBot:  "basic",
+		QueueWait: 200 * time.Millisecond,
+		Ratings:   fixedRatings{"ann": 1800, "bob": 1500},
+	})
+	conn := client.ConnectToService(svc, false)
+	wg := new(sync.WaitGroup)
+	gameIds := make(chan string)
+	queue(t, conn, wg, "ann", client.QueueOptions{MaxRatingGap: 100}, gameIds)
+	queue(t, conn, wg, "bob", client.QueueOptions{}, gameIds)
+	if a, b := <-gameIds, <-gameIds; a == b {
+		t.Errorf("ann and bob were both given game %s, want separate tables", a)
+	}
+	waitForGame(t, wg)
+}
+
+// Records why the server ended its stream.
+type refusedPlayer struct {
+	client.UnimplementedGameCallbacks
+	err error
+}
+
+func (p *refusedPlayer) HandleConnectionError(s client.Session, err error) {
+	p.err = err
+}
+
+// Tries to join gameId as name with joinCode, and returns why the server refused.
+func refusedJoin(t *testing.T, conn client.Connection, gameId, name, joinCode string) error {
+	t.Helper()
+	player := &refusedPlayer{}
+	session, err := conn.Register(context.Background(), name, player)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	if err := session.JoinGameWithCode(context.Background(), wg, gameId, joinCode); err != nil {
+		return err
+	}
+	waitForGame(t, wg)
+	if player.err == nil {
+		t.Fatalf("%s joined game %s, want them refused", name, gameId)
+	}
+	return player.err
+}
+
+// Reports the players who join a game to whichever players are already seated there,
+// so once n joins have been seen, n+1 of its players are seated.
+type joinWatch chan string
+
+// Registers a basic player called name that reports joins to w.
+func (w joinWatch) register(t *testing.T, conn client.Connection, name string) client.Session {
+	t.Helper()
+	player, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := conn.Register(context.Background(), name, watchingPlayer{player, w})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return session
+}
+
+// Waits for n more joins to be reported.
+func (w joinWatch) wait(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-w:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d of %d joins were seen", i, n)
+		}
+	}
+}
+
+type watchingPlayer struct {
+	client.GameCallbacks
+	joined joinWatch
+}
+
+func (p watchingPlayer) HandlePlayerJoined(s client.Session, name string, gameId string) error {
+	p.joined <- name
+	return p.GameCallbacks.HandlePlayerJoined(s, name, gameId)
+}
+
+func TestPrivateGame(t *testing.T) {
+	conn := newConnection()
+	ctx := context.Background()
+	joins := make(joinWatch, 10)
+	host := joins.register(t, conn, "ann")
+	gameId, err := conn.CreateGame(ctx, client.Private(), client.WithJoinCode("sesame"), client.WithReservedSeats("cat"), client.HostedBy(host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	games, err := conn.ListGames(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 0 {
+		t.Errorf("ListGames returned %v, want the private game hidden", games)
+	}
+	for _, tc := range []struct {
+		code string
+		want string
+	}{
+		{"", fmt.Sprintf("joining game %s needs its join code", gameId)},
+		{"open", fmt.Sprintf("wrong join code for game %s", gameId)},
+	} {
+		if err := refusedJoin(t, conn, gameId, "eve", tc.code); err.Error() != tc.want {
+			t.Errorf("joining with code %q: got %q, want %q", tc.code, err, tc.want)
+		}
+	}
+	wg := new(sync.WaitGroup)
+	if err := host.JoinGameWithCode(ctx, wg, gameId, "sesame"); err != nil {
+		t.Fatal(err)
+	}
+	if err := joins.register(t, conn, "bob").JoinGameWithCode(ctx, wg, gameId, "sesame"); err != nil {
+		t.Fatal(err)
+	}
+	joins.wait(t, 1)
+	if err := host.AddBot(ctx, gameId, "basic"); err != nil {
+		t.Fatal(err)
+	}
+	joins.wait(t, 2)
+	// The last seat is cat's.
+	if err := host.AddBot(ctx, gameId, "basic"); err == nil {
+		t.Errorf("AddBot took cat's seat, want an error")
+	}
+	if err := refusedJoin(t, conn, gameId, "eve", "sesame"); err == nil {
+		t.Errorf("eve took cat's seat")
+	}
+	// Watching the game needs the code too.
+	if _, err := conn.GetGameState(ctx, gameId); err == nil {
+		t.Errorf("GetGameState without the join code succeeded")
+	}
+	watcher := &refusedPlayer{}
+	session, err := conn.Register(ctx, "eve", watcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchWg := new(sync.WaitGroup)
+	if err := session.ObserveGame(ctx, watchWg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	waitForGame(t, watchWg)
+	if want := fmt.Sprintf("watching game %s needs its join code", gameId); watcher.err == nil || watcher.err.Error() != want {
+		t.Errorf("observing without the join code: got %v, want %q", watcher.err, want)
+	}
+	if err := joins.register(t, conn, "cat").JoinGameWithCode(ctx, wg, gameId, "sesame"); err != nil {
+		t.Fatal(err)
+	}
+	waitForGame(t, wg)
+	gs, err := conn.GetGameStateWithCode(ctx, gameId, "sesame")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gs.Phase != client.Completed {
+		t.Fatalf("game is %s, want Completed", gs.Phase)
+	}
+	var names []string
+	for _, p := range gs.Players {
+		if !p.IsBot {
+			names = append(names, p.Name)
+		}
+	}
+	if len(names) != 3 {
+		t.Errorf("people %v played, want ann, bob and cat", names)
+	}
+}
+
+func TestHostControls(t *testing.T) {
+	conn := newConnection()
+	ctx := context.Background()
+	joins := make(joinWatch, 10)
+	host := joins.register(t, conn, "ann")
+	gameId, err := conn.CreateGame(ctx, client.Private(), client.HostedBy(host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	if err := host.JoinGame(ctx, wg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	bobWg := new(sync.WaitGroup)
+	bob := joins.register(t, conn, "bob")
+	if err := bob.JoinGame(ctx, bobWg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	joins.wait(t, 1)
+	if err := bob.AddBot(ctx, gameId, "basic"); err == nil {
+		t.Errorf("bob added a bot to ann's game")
+	}
+	if err := bob.KickPlayer(ctx, gameId, "ann"); err == nil {
+		t.Errorf("bob kicked ann from her game")
+	}
+	if err := bob.StartGame(ctx, gameId, "basic"); err == nil {
+		t.Errorf("bob started ann's game")
+	}
+	if err := host.KickPlayer(ctx, gameId, "ann"); err == nil {
+		t.Errorf("ann kicked herself")
+	}
+	if err := host.KickPlayer(ctx, gameId, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	// Bob's game ends when he's kicked.
+	waitForGame(t, bobWg)
+	want := fmt.Sprintf("bob was removed from game %s by its host", gameId)
+	if err := refusedJoin(t, conn, gameId, "bob", ""); err.Error() != want {
+		t.Errorf("bob rejoining: got %q, want %q", err, want)
+	}
+	if err := host.StartGame(ctx, gameId, "random"); err != nil {
+		t.Fatal(err)
+	}
+	waitForGame(t, wg)
+	gs := finalState(t, conn, gameId)
+	for _, p := range gs.Players {
+		if p.Name != "ann" && !p.IsBot {
+			t.Errorf("%s played, want only ann and bots", p.Name)
+		}
+	}
+}
+
+// Starts bots with host, except that bots of type "broken" fail to start once release is closed.
+type brokenBotHost struct {
+	host    server.BotHost
+	release chan struct{}
+}
+
+func (b brokenBotHost) CheckPlayerType(playerType string) error {
+	if playerType == "broken" {
+		return nil
+	}
+	return b.host.CheckPlayerType(playerType)
+}
+
+func (b brokenBotHost) StartBot(service pb.CardGameServiceServer, gameId, playerType string) error {
+	if playerType == "broken" {
+		<-b.release
+		return fmt.Errorf("broken bots don't start")
+	}
+	return b.host.StartBot(service, gameId, playerType)
+}
+
+func TestAddBotInvites(t *testing.T) {
+	bots := brokenBotHost{host: hearts.NewBotHost(), release: make(chan struct{})}
+	conn := client.ConnectToService(server.NewCardGameService(server.Options{Bots: bots}), false)
+	ctx := context.Background()
+	basic, err := hearts.NewPlayerFromFlag("basic", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, err := conn.Register(ctx, "ann", basic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gameId, err := conn.CreateGame(ctx, client.HostedBy(host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg := new(sync.WaitGroup)
+	if err := host.JoinGame(ctx, wg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	// Joining finishes in the background.
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if gs, err := conn.GetGameState(ctx, gameId); err == nil && len(gs.Players) == 1 {
+			break
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if err := host.AddBot(ctx, gameId, "broken"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := host.AddBot(ctx, gameId, "broken"); err == nil {
+		t.Errorf("invited a fourth bot to a game with three empty seats")
+	}
+	// Bots that don't start give their seats back, so the host can fill them.
+	close(bots.release)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		err := host.StartGame(ctx, gameId, "basic")
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	finalState(t, conn, gameId)
+}
+
+func TestChooseSeat(t *testing.T) {
+	conn := newConnection()
+	ctx := context.Background()
+	gameId, err := conn.CreateGame(ctx, client.WithPartnerships())
+	if err != nil {
+		t.Fatal(err)
+	}
+	joins := make(joinWatch, 10)
+	wg := new(sync.WaitGroup)
+	ann := joins.register(t, conn, "ann")
+	if err := ann.JoinGame(ctx, wg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	bob := joins.register(t, conn, "bob")
+	if err := bob.JoinGame(ctx, wg, gameId); err != nil {
+		t.Fatal(err)
+	}
+	joins.wait(t, 1)
+	// Whoever joined first sits North, and ann moves across from there.
+	if err := ann.ChooseSeat(ctx, gameId, cards.South); err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.ChooseSeat(ctx, gameId, cards.South); err == nil {
+		t.Errorf("bob took ann's seat")
+	}
+	if err := bob.ChooseSeat(ctx, gameId, cards.North); err != nil {
+		t.Fatal(err)
+	}
+	gs, err := conn.GetGameState(ctx, gameId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gs.Players) != 2 || gs.Players[0].Name != "bob" || gs.Players[1].Name != "ann" || gs.Players[1].Seat != cards.South {
+		t.Errorf("seats before the game are %v, want bob North and ann South", gs.Players)
+	}
+	for _, botType := range []string{"basic", "random"} {
+		if err := ann.AddBot(ctx, gameId, botType); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForGame(t, wg)
+	gs = finalState(t, conn, gameId)
+	wantSeats := []cards.Seat{cards.North, cards.East, cards.South, cards.West}
+	total := 0
+	for i, p := range gs.Players {
+		if p.Seat != wantSeats[i] {
+			t.Errorf("player %d, %s, is sitting %s, want %s", i, p.Name, p.Seat, wantSeats[i])
+		}
+		if want := i%2 + 1; p.Team != want {
+			t.Errorf("%s is on team %d, want %d", p.Name, p.Team, want)
+		}
+		total += p.HandScore
+	}
+	if gs.Players[0].Name != "bob" || gs.Players[2].Name != "ann" {
+		t.Errorf("bob and ann aren't sitting across from each other: %v", gs)
+	}
+	teams := gs.TeamScores()
+	if teams[1]+teams[2] != total {
+		t.Errorf("team scores %v don't add up to the hand scores' total %d", teams, total)
+	}
+	if err := ann.ChooseSeat(ctx, gameId, cards.East); err == nil {
+		t.Errorf("ann changed seats after the game")
+	}
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mpsalisbury/cards/pkg/cards"
+	pb "github.com/mpsalisbury/cards/pkg/proto"
+)
+
+// Points taken by the player who takes them all.
+const moonPoints = 26
+
+func (s *cardGameService) GetPlayerStats(ctx context.Context, req *pb.PlayerStatsRequest) (*pb.PlayerStats, error) {
+	stats, err := s.playerStats()
+	if err != nil {
+		return nil, err
+	}
+	ps, ok := stats[req.GetPlayerName()]
+	if !ok {
+		return nil, fmt.Errorf("no completed games by %s", req.GetPlayerName())
+	}
+	return ps, nil
+}
+
+func (s *cardGameService) GetLeaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.Leaderboard, error) {
+	stats, err := s.playerStats()
+	if err != nil {
+		return nil, err
+	}
+	lb := &pb.Leaderboard{}
+	for _, ps := range stats {
+		if ps.GetGamesPlayed() >= req.GetMinGames() {
+			lb.Players = append(lb.Players, ps)
+		}
+	}
+	less := leaderboardOrder(req.GetOrder())
+	sort.Slice(lb.Players, func(i, j int) bool {
+		a, b := lb.Players[i], lb.Players[j]
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.GetName() < b.GetName()
+	})
+	if limit := int(req.GetLimit()); limit > 0 && len(lb.Players) > limit {
+		lb.Players = lb.Players[:limit]
+	}
+	return lb, nil
+}
+
+// Returns whether a ranks above b.
+func leaderboardOrder(order pb.LeaderboardRequest_Order) func(a, b *pb.PlayerStats) bool {
+	// Returns whether an/ad > bn/bd, without dividing.
+	ratioAbove := func(an, ad, bn, bd int32) bool {
+		return int64(an)*int64(bd) > int64(bn)*int64(ad)
+	}
+	switch order {
+	case pb.LeaderboardRequest_AverageHandScore:
+		return func(a, b *pb.PlayerStats) bool {
+			return ratioAbove(b.GetTotalHandScore(), b.GetGamesPlayed(), a.GetTotalHandScore(), a.GetGamesPlayed())
+		}
+	case pb.LeaderboardRequest_GamesPlayed:
+		return func(a, b *pb.PlayerStats) bool { return a.GetGamesPlayed() > b.GetGamesPlayed() }
+	case pb.LeaderboardRequest_MoonShots:
+		return func(a, b *pb.PlayerStats) bool { return a.GetMoonShots() > b.GetMoonShots() }
+	case pb.LeaderboardRequest_Rating:
+		return func(a, b *pb.PlayerStats) bool { return a.GetRating() > b.GetRating() }
+	default:
+		return func(a, b *pb.PlayerStats) bool {
+			return ratioAbove(a.GetWins(), a.GetGamesPlayed(), b.GetWins(), b.GetGamesPlayed())
+		}
+	}
+}
+
+// Returns the statistics of every player in the history, keyed by name.
+func (s *cardGameService) playerStats() (map[string]*pb.PlayerStats, error) {
+	hs, err := s.history.ListGames()
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]*pb.PlayerStats)
+	for _, h := range hs {
+		if h.GetPhase() == pb.GameState_Completed {
+			addGameStats(stats, h)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name, ps := range stats {
+		ps.Rating = s.ratingOf(name)
+	}
+	return stats, nil
+}
+
+// Adds a completed game to the statistics of the players who started it.
+func addGameStats(stats map[string]*pb.PlayerStats, h *pb.GameHistory) {
+	events := h.GetEvents()
+	if len(events) == 0 {
+		return
+	}
+	scores := events[len(events)-1].GetGameFinished().GetHandScores()
+	players := h.GetPlayers()
+	if len(scores) != len(players) {
+		return
+	}
+	// A replacement bot plays under the id of the seat it takes over.
+	seats := make(map[string]int)
+	for i, p := range players {
+		seats[p.GetId()] = i
+	}
+	points := make([]int, len(players))
+	queens := make([]int, len(players))
+	for _, e := range events {
+		tc := e.GetTrickCompleted()
+		if tc == nil {
+			continue
+		}
+		seat, ok := seats[tc.GetWinnerId()]
+		if !ok {
+			continue
+		}
+		trick, err := cards.ParseCards(tc.GetTrick())
+		if err != nil {
+			continue
+		}
+		for _, c := range trick {
+			switch {
+			case c == cards.Cqs:
+				points[seat] += 13
+				queens[seat]++
+			case c.Suit == cards.Hearts:
+				points[seat]++
+			}
+		}
+	}
+	lowest := scores[0]
+	for _, score := range scores {
+		if score < lowest {
+			lowest = score
+		}
+	}
+	// Bots of the same type share a name. Each name counts once a game, from its best seat.
+	var names []string
+	best := make(map[string]int)
+	for i, p := range players {
+		name := p.GetName()
+		if name == "" {
+			continue
+		}
+		if j, ok := best[name]; !ok {
+			names = append(names, name)
+			best[name] = i
+		} else if scores[i] < scores[j] {
+			best[name] = i
+		}
+	}
+	for _, name := range names {
+		i := best[name]
+		ps, ok := stats[name]
+		if !ok {
+			ps = &pb.PlayerStats{Name: name}
+			stats[name] = ps
+		}
+		ps.GamesPlayed++
+		ps.TotalHandScore += scores[i]
+		if scores[i] == lowest {
+			ps.Wins++
+		}
+		if points[i] == moonPoints {
+			ps.MoonShots++
+		}
+		ps.QueenOfSpadesTaken += int32(queens[i])
+	}
+}
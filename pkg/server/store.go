@@ -0,0 +1,89 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GameStore keeps games so that they survive the server restarting.
+type GameStore interface {
+	// Saves a game, replacing any earlier save of it.
+	SaveGame(gameId string, data []byte) error
+	// Deletes a game's save, if there is one.
+	DeleteGame(gameId string) error
+	// Returns every saved game, keyed by gameId.
+	LoadGames() (map[string][]byte, error)
+}
+
+const savedGameSuffix = ".game"
+
+// Returns a GameStore that saves each game in a file in dir, creating dir if needed.
+func NewFileStore(dir string) (GameStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return fileStore{dir: dir}, nil
+}
+
+type fileStore struct {
+	dir string
+}
+
+func (st fileStore) path(gameId string) string {
+	return filepath.Join(st.dir, gameId+savedGameSuffix)
+}
+
+func (st fileStore) SaveGame(gameId string, data []byte) error {
+	return writeFile(st.dir, st.path(gameId), data)
+}
+
+func (st fileStore) DeleteGame(gameId string) error {
+	err := os.Remove(st.path(gameId))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (st fileStore) LoadGames() (map[string][]byte, error) {
+	entries, err := os.ReadDir(st.dir)
+	if err != nil {
+		return nil, err
+	}
+	games := make(map[string][]byte)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), savedGameSuffix) {
+			continue
+		}
+		gameId := strings.TrimSuffix(e.Name(), savedGameSuffix)
+		data, err := os.ReadFile(filepath.Join(st.dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("can't read saved game %s: %w", gameId, err)
+		}
+		games[gameId] = data
+	}
+	return games, nil
+}
+
+// Writes data to path in dir by writing a new file and renaming it into place,
+// so a crash can't leave half a file.
+func writeFile(dir, path string, data []byte) error {
+	f, err := os.CreateTemp(dir, filepath.Base(path)+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}